@@ -0,0 +1,142 @@
+package export
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/parquet-go/parquet-go"
+
+	"pingpong/domain"
+)
+
+type Format string
+
+const (
+	FormatJSONL   Format = "jsonl"
+	FormatCSV     Format = "csv"
+	FormatParquet Format = "parquet"
+)
+
+// CSVHeader is the column layout of match_log.csv.
+var CSVHeader = []string{"time", "turn_number", "player", "ball_power", "routine_id", "match_number"}
+
+func ParseFormat(value string) (Format, error) {
+	switch format := Format(value); format {
+	case FormatJSONL, FormatCSV, FormatParquet:
+		return format, nil
+	case "json":
+		return FormatJSONL, nil
+	default:
+		return "", fmt.Errorf("unknown export format %q (expected jsonl, csv or parquet)", value)
+	}
+}
+
+type Writer interface {
+	WriteMatch(match domain.Match) error
+	Close() error
+}
+
+func NewWriter(w io.Writer, format Format) (Writer, error) {
+	switch format {
+	case FormatJSONL:
+		return &jsonlWriter{enc: json.NewEncoder(w)}, nil
+	case FormatCSV:
+		cw := csv.NewWriter(w)
+		if err := cw.Write(CSVHeader); err != nil {
+			return nil, err
+		}
+		return &csvWriter{w: cw}, nil
+	case FormatParquet:
+		return &parquetWriter{w: parquet.NewGenericWriter[turnRow](w)}, nil
+	default:
+		return nil, fmt.Errorf("unsupported export format %q", format)
+	}
+}
+
+type jsonlWriter struct {
+	enc *json.Encoder
+}
+
+func (w *jsonlWriter) WriteMatch(match domain.Match) error {
+	return w.enc.Encode(match)
+}
+
+func (w *jsonlWriter) Close() error {
+	return nil
+}
+
+type csvWriter struct {
+	w *csv.Writer
+}
+
+func CSVRecord(turn domain.Turn) []string {
+	return []string{
+		turn.Time.Format(time.RFC3339),
+		strconv.Itoa(turn.TurnNumber),
+		turn.Player,
+		strconv.Itoa(turn.BallPower),
+		turn.RoutineID,
+		strconv.Itoa(turn.MatchNumber),
+	}
+}
+
+func (w *csvWriter) WriteMatch(match domain.Match) error {
+	for _, turn := range match.Turns {
+		if err := w.w.Write(CSVRecord(turn)); err != nil {
+			return err
+		}
+	}
+	w.w.Flush()
+	return w.w.Error()
+}
+
+func (w *csvWriter) Close() error {
+	w.w.Flush()
+	return w.w.Error()
+}
+
+// turnRow is one Parquet row: a turn denormalised with the match it belongs to.
+type turnRow struct {
+	MatchID     int64     `parquet:"match_id"`
+	MatchNumber int64     `parquet:"match_number"`
+	StartTime   time.Time `parquet:"start_time,timestamp(millisecond)"`
+	EndTime     time.Time `parquet:"end_time,timestamp(millisecond),optional"`
+	Winner      string    `parquet:"winner,dict"`
+	RoutineID   string    `parquet:"routine_id,dict"`
+	TurnNumber  int64     `parquet:"turn_number"`
+	Time        time.Time `parquet:"time,timestamp(millisecond)"`
+	Player      string    `parquet:"player,dict"`
+	BallPower   int64     `parquet:"ball_power"`
+}
+
+type parquetWriter struct {
+	w *parquet.GenericWriter[turnRow]
+}
+
+func (w *parquetWriter) WriteMatch(match domain.Match) error {
+	rows := make([]turnRow, len(match.Turns))
+	for i, turn := range match.Turns {
+		rows[i] = turnRow{
+			MatchID:     int64(match.ID),
+			MatchNumber: int64(match.MatchNumber),
+			StartTime:   match.StartTime,
+			EndTime:     match.EndTime,
+			Winner:      match.Winner,
+			RoutineID:   turn.RoutineID,
+			TurnNumber:  int64(turn.TurnNumber),
+			Time:        turn.Time,
+			Player:      turn.Player,
+			BallPower:   int64(turn.BallPower),
+		}
+	}
+	_, err := w.w.Write(rows)
+	return err
+}
+
+func (w *parquetWriter) Close() error {
+	return w.w.Close()
+}
@@ -2,9 +2,10 @@ package grpc
 
 import (
 	"context"
-	"log"
 
 	"google.golang.org/grpc"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/protobuf/types/known/emptypb"
 	pb "pingpong/proto"
 )
 
@@ -12,49 +13,127 @@ func NewPlayerClientFromConn(conn *grpc.ClientConn) pb.PlayerServiceClient {
 	return pb.NewPlayerServiceClient(conn)
 }
 
-func StartNewMatch(client pb.PlayerServiceClient) (*pb.NewMatchResponse, error) {
-	log.Println("📤 Client sending StartNewMatch request")
-	return client.StartNewMatch(context.Background(), &pb.NewMatchRequest{})
+func StartNewMatch(ctx context.Context, client pb.PlayerServiceClient) (*pb.NewMatchResponse, error) {
+	playerLog.DebugContext(ctx, "📤 Client sending StartNewMatch request")
+	return client.StartNewMatch(ctx, &pb.NewMatchRequest{})
 }
 
-func PlayerAPing(client pb.PlayerServiceClient, ballPower int32) (*pb.PingResponse, error) {
-	log.Printf("📤 Client sending PlayerAPing request with power: %d", ballPower)
-	return client.PlayerAPing(context.Background(), &pb.PingRequest{BallPower: ballPower})
+func PlayerAPing(ctx context.Context, client pb.PlayerServiceClient, ballPower int32) (*pb.PingResponse, error) {
+	playerLog.DebugContext(ctx, "📤 Client sending PlayerAPing request", "power", ballPower)
+	return client.PlayerAPing(ctx, &pb.PingRequest{BallPower: ballPower})
 }
 
-func PlayerBPing(client pb.PlayerServiceClient, ballPower int32) (*pb.PingResponse, error) {
-	log.Printf("📤 Client sending PlayerBPing request with power: %d", ballPower)
-	return client.PlayerBPing(context.Background(), &pb.PingRequest{BallPower: ballPower})
+func PlayerBPing(ctx context.Context, client pb.PlayerServiceClient, ballPower int32) (*pb.PingResponse, error) {
+	playerLog.DebugContext(ctx, "📤 Client sending PlayerBPing request", "power", ballPower)
+	return client.PlayerBPing(ctx, &pb.PingRequest{BallPower: ballPower})
 }
 
-func GetMatch(client pb.PlayerServiceClient) (*pb.Match, error) {
-	log.Println("📤 Client sending GetMatch request")
-	return client.GetMatch(context.Background(), &pb.GetMatchRequest{})
+func GetMatch(ctx context.Context, client pb.PlayerServiceClient) (*pb.Match, error) {
+	playerLog.DebugContext(ctx, "📤 Client sending GetMatch request")
+	return client.GetMatch(ctx, &pb.GetMatchRequest{})
 }
 
-func GetMatchByID(client pb.PlayerServiceClient, id int32) (*pb.Match, error) {
-	log.Printf("📤 Client sending GetMatchByID request for ID: %d", id)
-	return client.GetMatchByID(context.Background(), &pb.GetMatchByIDRequest{Id: id})
+func GetMatchByID(ctx context.Context, client pb.PlayerServiceClient, id int32) (*pb.Match, error) {
+	playerLog.DebugContext(ctx, "📤 Client sending GetMatchByID request", "id", id)
+	return client.GetMatchByID(ctx, &pb.GetMatchByIDRequest{Id: id})
 }
 
-func TestDB(client pb.PlayerServiceClient) (*pb.TestDBResponse, error) {
-	log.Println("📤 Client sending TestDB request")
-	return client.TestDB(context.Background(), &pb.TestDBRequest{})
+func ListMatches(ctx context.Context, client pb.PlayerServiceClient, req *pb.ListMatchesRequest) (*pb.ListMatchesResponse, error) {
+	playerLog.DebugContext(ctx, "📤 Client sending ListMatches request", "player_filter", req.Player, "limit", req.Limit)
+	return client.ListMatches(ctx, req)
+}
+
+func GetPlayerStats(ctx context.Context, client pb.PlayerServiceClient, player string) (*pb.PlayerSummary, error) {
+	playerLog.DebugContext(ctx, "📤 Client sending GetPlayerStats request", "player_id", player)
+	return client.GetPlayerStats(ctx, &pb.GetPlayerStatsRequest{PlayerId: player})
+}
+
+func GetMatchStats(ctx context.Context, client pb.PlayerServiceClient, id int32) (*pb.MatchStats, error) {
+	playerLog.DebugContext(ctx, "📤 Client sending GetMatchStats request", "id", id)
+	return client.GetMatchStats(ctx, &pb.GetMatchByIDRequest{Id: id})
+}
+
+func ExportMatches(ctx context.Context, client pb.PlayerServiceClient, req *pb.ExportMatchesRequest) (pb.PlayerService_ExportMatchesClient, error) {
+	playerLog.DebugContext(ctx, "📤 Client sending ExportMatches request", "format", req.Format)
+	return client.ExportMatches(ctx, req)
+}
+
+func WatchMatch(ctx context.Context, client pb.PlayerServiceClient, id int32) (pb.PlayerService_WatchMatchClient, error) {
+	playerLog.DebugContext(ctx, "📤 Client sending WatchMatch request", "id", id)
+	return client.WatchMatch(ctx, &pb.WatchMatchRequest{MatchId: id})
+}
+
+func PauseMatch(ctx context.Context, client pb.PlayerServiceClient, paused bool) (*pb.PauseMatchResponse, error) {
+	playerLog.DebugContext(ctx, "📤 Client sending PauseMatch request", "paused", paused)
+	return client.PauseMatch(ctx, &pb.PauseMatchRequest{Paused: paused})
+}
+
+func ReplayMatch(ctx context.Context, client pb.PlayerServiceClient, id int32, speed float64) (pb.PlayerService_ReplayMatchClient, error) {
+	playerLog.DebugContext(ctx, "📤 Client sending ReplayMatch request", "id", id, "speed", speed)
+	return client.ReplayMatch(ctx, &pb.ReplayMatchRequest{MatchId: id, Speed: speed})
+}
+
+func HitBall(ctx context.Context, client pb.PlayerServiceClient, power pb.ShotPower, placement pb.ShotPlacement) (*pb.HitBallResponse, error) {
+	playerLog.DebugContext(ctx, "📤 Client sending HitBall request", "power", power.String(), "placement", placement.String())
+	return client.HitBall(ctx, &pb.HitBallRequest{Power: power, Placement: placement})
+}
+
+func IsGameActive(ctx context.Context, client pb.PlayerServiceClient) (*pb.IsGameActiveResponse, error) {
+	playerLog.DebugContext(ctx, "📤 Client sending IsGameActive request")
+	return client.IsGameActive(ctx, &emptypb.Empty{})
+}
+
+func GetGameRules(ctx context.Context, client pb.PlayerServiceClient) (*pb.GameRules, error) {
+	playerLog.DebugContext(ctx, "📤 Client sending GetGameRules request")
+	return client.GetGameRules(ctx, &emptypb.Empty{})
+}
+
+func SetGameRules(ctx context.Context, client pb.PlayerServiceClient, rules *pb.GameRules) (*pb.GameRules, error) {
+	playerLog.DebugContext(ctx, "📤 Client sending SetGameRules request", "turn_limit", rules.TurnLimit)
+	return client.SetGameRules(ctx, rules)
+}
+
+func CreateSchedule(ctx context.Context, client pb.PlayerServiceClient, req *pb.CreateScheduleRequest) (*pb.Schedule, error) {
+	playerLog.DebugContext(ctx, "📤 Client sending CreateSchedule request", "name", req.Name, "cron", req.Cron)
+	return client.CreateSchedule(ctx, req)
+}
+
+func ListSchedules(ctx context.Context, client pb.PlayerServiceClient) (*pb.ListSchedulesResponse, error) {
+	playerLog.DebugContext(ctx, "📤 Client sending ListSchedules request")
+	return client.ListSchedules(ctx, &emptypb.Empty{})
+}
+
+func DeleteSchedule(ctx context.Context, client pb.PlayerServiceClient, id int32) error {
+	playerLog.DebugContext(ctx, "📤 Client sending DeleteSchedule request", "id", id)
+	_, err := client.DeleteSchedule(ctx, &pb.DeleteScheduleRequest{Id: id})
+	return err
+}
+
+func TestDB(ctx context.Context, client pb.PlayerServiceClient) (*pb.TestDBResponse, error) {
+	playerLog.DebugContext(ctx, "📤 Client sending TestDB request")
+	return client.TestDB(ctx, &pb.TestDBRequest{})
+}
+
+// CheckHealth asks the grpc.health.v1 service for the status of service; an
+// empty name is the server's overall status.
+func CheckHealth(ctx context.Context, conn *grpc.ClientConn, service string) (*healthpb.HealthCheckResponse, error) {
+	playerLog.DebugContext(ctx, "📤 Client sending health check", "service", service)
+	return healthpb.NewHealthClient(conn).Check(ctx, &healthpb.HealthCheckRequest{Service: service})
 }
 
 func NewTableClientFromConn(conn *grpc.ClientConn) pb.TableServiceClient {
 	return pb.NewTableServiceClient(conn)
 }
 
-func StartGame(client pb.TableServiceClient) (*pb.StartGameResponse, error) {
-	log.Println("📤 Client sending StartGame request")
-	return client.StartGame(context.Background(), &pb.StartGameRequest{})
+func StartGame(ctx context.Context, client pb.TableServiceClient) (*pb.StartGameResponse, error) {
+	tableLog.DebugContext(ctx, "📤 Client sending StartGame request")
+	return client.StartGame(ctx, &pb.StartGameRequest{})
 }
 
-func ReceiveBall(client pb.TableServiceClient, ballPower int32, fromPlayer string) (*pb.ReceiveBallResponse, error) {
-	log.Printf("📤 Client sending ReceiveBall request: power %d from player %s", ballPower, fromPlayer)
-	return client.ReceiveBall(context.Background(), &pb.ReceiveBallRequest{
+func ReceiveBall(ctx context.Context, client pb.TableServiceClient, ballPower int32, fromPlayer string) (*pb.ReceiveBallResponse, error) {
+	tableLog.DebugContext(ctx, "📤 Client sending ReceiveBall request", "power", ballPower, "from", fromPlayer)
+	return client.ReceiveBall(ctx, &pb.ReceiveBallRequest{
 		BallPower:  ballPower,
 		FromPlayer: fromPlayer,
 	})
-}
\ No newline at end of file
+}
@@ -1,6 +1,7 @@
 package grpc
 
 import (
+	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 	
 	"pingpong/domain"
@@ -18,6 +19,9 @@ func DomainMatchToProto(match domain.Match) *pb.Match {
 	if !match.EndTime.IsZero() {
 		pbMatch.EndTime = timestamppb.New(match.EndTime)
 	}
+	if match.Rules != nil {
+		pbMatch.GameRules = DomainGameRulesToProto(*match.Rules)
+	}
 
 	pbTurns := make([]*pb.Turn, len(match.Turns))
 	for i, turn := range match.Turns {
@@ -51,6 +55,10 @@ func ProtoToDomainMatch(pbMatch *pb.Match) domain.Match {
 	if pbMatch.EndTime != nil {
 		match.EndTime = pbMatch.EndTime.AsTime()
 	}
+	if pbMatch.GameRules != nil {
+		rules := ProtoToDomainGameRules(pbMatch.GameRules)
+		match.Rules = &rules
+	}
 
 	turns := make([]domain.Turn, len(pbMatch.Turns))
 	for i, pbTurn := range pbMatch.Turns {
@@ -71,4 +79,80 @@ func ProtoToDomainTurn(pbTurn *pb.Turn) domain.Turn {
 		RoutineID:   pbTurn.RoutineId,
 		MatchNumber: int(pbTurn.MatchNumber),
 	}
-}
\ No newline at end of file
+}
+
+func DomainGameRulesToProto(rules domain.GameRules) *pb.GameRules {
+	return &pb.GameRules{
+		TurnLimit:      int32(rules.TurnLimit),
+		ServePower:     domainPowerRangeToProto(rules.ServePower),
+		ReturnPercentA: domainPowerRangeToProto(rules.ReturnPercentA),
+		ReturnPowerB:   domainPowerRangeToProto(rules.ReturnPowerB),
+	}
+}
+
+func domainPowerRangeToProto(r domain.PowerRange) *pb.PowerRange {
+	return &pb.PowerRange{Min: int32(r.Min), Max: int32(r.Max)}
+}
+
+func ProtoToDomainGameRules(pbRules *pb.GameRules) domain.GameRules {
+	return domain.GameRules{
+		TurnLimit:      int(pbRules.TurnLimit),
+		ServePower:     protoToDomainPowerRange(pbRules.ServePower),
+		ReturnPercentA: protoToDomainPowerRange(pbRules.ReturnPercentA),
+		ReturnPowerB:   protoToDomainPowerRange(pbRules.ReturnPowerB),
+	}
+}
+
+func protoToDomainPowerRange(r *pb.PowerRange) domain.PowerRange {
+	return domain.PowerRange{Min: int(r.GetMin()), Max: int(r.GetMax())}
+}
+
+func DomainPlayerSummaryToProto(summary domain.PlayerSummary) *pb.PlayerSummary {
+	return &pb.PlayerSummary{
+		PlayerId:         summary.Player,
+		Matches:          int32(summary.Matches),
+		Wins:             int32(summary.Wins),
+		Losses:           int32(summary.Losses),
+		Draws:            int32(summary.Draws),
+		Hits:             int32(summary.Hits),
+		AverageBallPower: summary.AverageBallPower,
+	}
+}
+
+func ProtoToDomainMatchFilter(req *pb.ListMatchesRequest) domain.MatchFilter {
+	filter := domain.MatchFilter{
+		Player: req.Player,
+		Limit:  int(req.Limit),
+	}
+	if req.From != nil {
+		filter.From = req.From.AsTime()
+	}
+	if req.To != nil {
+		filter.To = req.To.AsTime()
+	}
+	return filter
+}
+
+func DomainMatchStatsToProto(stats domain.MatchStats) *pb.MatchStats {
+	pbStats := &pb.MatchStats{
+		MatchId:     int32(stats.MatchID),
+		Rally:       int32(stats.Rally),
+		Duration:    durationpb.New(stats.Duration),
+		Pauses:      int32(stats.Pauses),
+		Winner:      stats.Winner,
+		FinishedBy:  stats.FinishedBy,
+		PlayerStats: make(map[string]*pb.PlayerStats, len(stats.PlayerStats)),
+	}
+
+	for player, p := range stats.PlayerStats {
+		pbStats.PlayerStats[player] = &pb.PlayerStats{
+			Hits:           int32(p.Hits),
+			AverageReceive: p.AverageReceive,
+			AverageReturn:  p.AverageReturn,
+			MaxReturn:      int32(p.MaxReturn),
+			Points:         int32(p.Points),
+		}
+	}
+
+	return pbStats
+}
@@ -0,0 +1,117 @@
+package grpc
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"strconv"
+	"time"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/protoadapt"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"pingpong/domain"
+)
+
+const (
+	errorDomain         = "pingpong"
+	storageRetryDelay   = 5 * time.Second
+	matchResourceType   = "pingpong.Match"
+	playerResourceType  = "pingpong.Player"
+	reasonNotFound      = "MATCH_NOT_FOUND"
+	reasonNoSchedule    = "SCHEDULE_NOT_FOUND"
+	reasonUnavailable   = "STORAGE_UNAVAILABLE"
+	reasonInvalid       = "INVALID_ARGUMENT"
+	reasonNoActiveMatch = "NO_ACTIVE_MATCH"
+	reasonInProgress    = "MATCH_IN_PROGRESS"
+	reasonNoShotPending = "NO_SHOT_PENDING"
+	reasonShuttingDown  = "SHUTTING_DOWN"
+	reasonInternalError = "INTERNAL"
+)
+
+// StatusCode maps a domain error to the gRPC code clients should see.
+func StatusCode(err error) codes.Code {
+	switch {
+	case errors.Is(err, domain.ErrMatchNotFound), errors.Is(err, domain.ErrScheduleNotFound):
+		return codes.NotFound
+	case errors.Is(err, domain.ErrStorageUnavailable), errors.Is(err, domain.ErrShuttingDown):
+		return codes.Unavailable
+	case errors.Is(err, domain.ErrInvalidArgument):
+		return codes.InvalidArgument
+	case errors.Is(err, domain.ErrNoActiveMatch), errors.Is(err, domain.ErrNoShotPending),
+		errors.Is(err, domain.ErrMatchInProgress):
+		return codes.FailedPrecondition
+	default:
+		return codes.Internal
+	}
+}
+
+// toStatus turns an error from the service layer into a gRPC status with an
+// ErrorInfo detail, plus ResourceInfo for missing resources and RetryInfo when
+// the database is down. resource names what was asked for, e.g. a match ID,
+// and may be empty.
+func toStatus(err error, message string, resourceType string, resource string) error {
+	code := StatusCode(err)
+	st := status.New(code, fmt.Sprintf("%s: %v", message, err))
+
+	details := []protoadapt.MessageV1{}
+	switch code {
+	case codes.NotFound:
+		reason := reasonNotFound
+		if errors.Is(err, domain.ErrScheduleNotFound) {
+			reason = reasonNoSchedule
+		}
+		details = append(details, errorInfo(reason, resourceType, resource))
+		if resource != "" {
+			details = append(details, &errdetails.ResourceInfo{
+				ResourceType: resourceType,
+				ResourceName: resource,
+				Description:  err.Error(),
+			})
+		}
+	case codes.Unavailable:
+		if errors.Is(err, domain.ErrShuttingDown) {
+			details = append(details, errorInfo(reasonShuttingDown, resourceType, resource))
+			break
+		}
+		details = append(details,
+			errorInfo(reasonUnavailable, resourceType, resource),
+			&errdetails.RetryInfo{RetryDelay: durationpb.New(storageRetryDelay)},
+		)
+	case codes.InvalidArgument:
+		details = append(details, errorInfo(reasonInvalid, resourceType, resource))
+	case codes.FailedPrecondition:
+		reason := reasonNoActiveMatch
+		switch {
+		case errors.Is(err, domain.ErrNoShotPending):
+			reason = reasonNoShotPending
+		case errors.Is(err, domain.ErrMatchInProgress):
+			reason = reasonInProgress
+		}
+		details = append(details, errorInfo(reason, resourceType, resource))
+	default:
+		details = append(details, errorInfo(reasonInternalError, resourceType, resource))
+	}
+
+	withDetails, detailErr := st.WithDetails(details...)
+	if detailErr != nil {
+		slog.Warn("⚠️ Failed to attach error details", "err", detailErr)
+		return st.Err()
+	}
+	return withDetails.Err()
+}
+
+func errorInfo(reason string, resourceType string, resource string) *errdetails.ErrorInfo {
+	info := &errdetails.ErrorInfo{Reason: reason, Domain: errorDomain}
+	if resource != "" {
+		info.Metadata = map[string]string{"resource_type": resourceType, "resource": resource}
+	}
+	return info
+}
+
+func matchStatus(err error, message string, id int) error {
+	return toStatus(err, message, matchResourceType, strconv.Itoa(id))
+}
@@ -0,0 +1,93 @@
+package grpc
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"pingpong/domain"
+)
+
+func TestToStatus(t *testing.T) {
+	tests := []struct {
+		name         string
+		err          error
+		resource     string
+		code         codes.Code
+		reason       string
+		resourceInfo bool
+		retryDelay   time.Duration
+	}{
+		{"match not found", domain.ErrMatchNotFound, "7", codes.NotFound, reasonNotFound, true, 0},
+		{"match not found without resource", domain.ErrMatchNotFound, "", codes.NotFound, reasonNotFound, false, 0},
+		{"schedule not found", domain.ErrScheduleNotFound, "3", codes.NotFound, reasonNoSchedule, true, 0},
+		{"storage unavailable", fmt.Errorf("ping: %w", domain.ErrStorageUnavailable), "7", codes.Unavailable, reasonUnavailable, false, storageRetryDelay},
+		{"shutting down", domain.ErrShuttingDown, "", codes.Unavailable, reasonShuttingDown, false, 0},
+		{"invalid argument", fmt.Errorf("%w: id must be positive", domain.ErrInvalidArgument), "", codes.InvalidArgument, reasonInvalid, false, 0},
+		{"no active match", domain.ErrNoActiveMatch, "", codes.FailedPrecondition, reasonNoActiveMatch, false, 0},
+		{"no shot pending", domain.ErrNoShotPending, "", codes.FailedPrecondition, reasonNoShotPending, false, 0},
+		{"match in progress", domain.ErrMatchInProgress, "", codes.FailedPrecondition, reasonInProgress, false, 0},
+		{"internal", errors.New("boom"), "7", codes.Internal, reasonInternalError, false, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := StatusCode(tt.err); got != tt.code {
+				t.Errorf("StatusCode() = %v, want %v", got, tt.code)
+			}
+
+			st, ok := status.FromError(toStatus(tt.err, "request failed", matchResourceType, tt.resource))
+			if !ok {
+				t.Fatal("toStatus() did not return a gRPC status")
+			}
+			if st.Code() != tt.code {
+				t.Errorf("code = %v, want %v", st.Code(), tt.code)
+			}
+
+			var info *errdetails.ErrorInfo
+			var resource *errdetails.ResourceInfo
+			var retry *errdetails.RetryInfo
+			for _, detail := range st.Details() {
+				switch d := detail.(type) {
+				case *errdetails.ErrorInfo:
+					info = d
+				case *errdetails.ResourceInfo:
+					resource = d
+				case *errdetails.RetryInfo:
+					retry = d
+				default:
+					t.Errorf("unexpected detail %T", detail)
+				}
+			}
+
+			if info == nil {
+				t.Fatal("missing ErrorInfo")
+			}
+			if info.Reason != tt.reason || info.Domain != errorDomain {
+				t.Errorf("ErrorInfo = %s/%s, want %s/%s", info.Domain, info.Reason, errorDomain, tt.reason)
+			}
+			if tt.resource != "" && info.Metadata["resource"] != tt.resource {
+				t.Errorf("ErrorInfo resource = %q, want %q", info.Metadata["resource"], tt.resource)
+			}
+
+			if tt.resourceInfo != (resource != nil) {
+				t.Errorf("ResourceInfo present = %t, want %t", resource != nil, tt.resourceInfo)
+			}
+			if resource != nil && (resource.ResourceType != matchResourceType || resource.ResourceName != tt.resource) {
+				t.Errorf("ResourceInfo = %s %s, want %s %s", resource.ResourceType, resource.ResourceName, matchResourceType, tt.resource)
+			}
+
+			if (tt.retryDelay != 0) != (retry != nil) {
+				t.Errorf("RetryInfo present = %t, want %t", retry != nil, tt.retryDelay != 0)
+			}
+			if retry != nil && retry.RetryDelay.AsDuration() != tt.retryDelay {
+				t.Errorf("RetryInfo delay = %v, want %v", retry.RetryDelay.AsDuration(), tt.retryDelay)
+			}
+		})
+	}
+}
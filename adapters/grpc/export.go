@@ -0,0 +1,88 @@
+package grpc
+
+import (
+	"bufio"
+	"fmt"
+
+	"google.golang.org/grpc"
+
+	"pingpong/adapters/export"
+	"pingpong/domain"
+	pb "pingpong/proto"
+)
+
+const exportChunkSize = 32 * 1024
+
+var exportFormats = map[pb.ExportFormat]export.Format{
+	pb.ExportFormat_EXPORT_FORMAT_JSONL:   export.FormatJSONL,
+	pb.ExportFormat_EXPORT_FORMAT_CSV:     export.FormatCSV,
+	pb.ExportFormat_EXPORT_FORMAT_PARQUET: export.FormatParquet,
+}
+
+func ExportFormatToProto(format export.Format) pb.ExportFormat {
+	for pbFormat, f := range exportFormats {
+		if f == format {
+			return pbFormat
+		}
+	}
+	return pb.ExportFormat_EXPORT_FORMAT_JSONL
+}
+
+type chunkWriter struct {
+	stream grpc.ServerStreamingServer[pb.ExportChunk]
+}
+
+func (w chunkWriter) Write(p []byte) (int, error) {
+	written := 0
+	for written < len(p) {
+		n := min(len(p)-written, exportChunkSize)
+		if err := w.stream.Send(&pb.ExportChunk{Data: p[written : written+n]}); err != nil {
+			return written, err
+		}
+		written += n
+	}
+	return written, nil
+}
+
+func (s *PlayerServer) ExportMatches(req *pb.ExportMatchesRequest, stream grpc.ServerStreamingServer[pb.ExportChunk]) error {
+	format, ok := exportFormats[req.Format]
+	if !ok {
+		return toStatus(fmt.Errorf("export format %v: %w", req.Format, domain.ErrInvalidArgument), "unsupported export format", "", "")
+	}
+
+	filter := domain.MatchFilter{Player: req.Player}
+	if req.From != nil {
+		filter.From = req.From.AsTime()
+	}
+	if req.To != nil {
+		filter.To = req.To.AsTime()
+	}
+
+	playerLog.InfoContext(stream.Context(), "📦 Exporting matches", "format", format)
+
+	buf := bufio.NewWriterSize(chunkWriter{stream: stream}, exportChunkSize)
+	w, err := export.NewWriter(buf, format)
+	if err != nil {
+		return err
+	}
+
+	count := 0
+	err = s.matchService.StreamMatches(stream.Context(), filter, func(match domain.Match) error {
+		count++
+		return w.WriteMatch(match)
+	})
+	if err != nil {
+		playerLog.ErrorContext(stream.Context(), "❌ Export failed", "err", err)
+		return toStatus(err, "export failed", matchResourceType, "")
+	}
+
+	if err := w.Close(); err != nil {
+		return toStatus(err, "export failed", "", "")
+	}
+	if err := buf.Flush(); err != nil {
+		return toStatus(err, "export failed", "", "")
+	}
+
+	playerLog.InfoContext(stream.Context(), "✅ Exported matches", "count", count)
+	return nil
+}
@@ -0,0 +1,97 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	pb "pingpong/proto"
+)
+
+const (
+	HealthCheckInterval = 10 * time.Second
+	healthCheckTimeout  = 2 * time.Second
+)
+
+// A healthCheck is reported as its own service in grpc.health.v1, so clients
+// can ask for e.g. "pingpong.database" as well as the overall status.
+type healthCheck struct {
+	name  string
+	check func(ctx context.Context) error
+}
+
+func (s *PlayerServer) healthChecks() []healthCheck {
+	return []healthCheck{
+		{name: "pingpong.database", check: func(ctx context.Context) error {
+			_, err := s.matchService.TestConnection(ctx)
+			return err
+		}},
+		{name: "pingpong.database.tables", check: s.matchService.CheckTables},
+		{name: "pingpong.table", check: s.checkTable},
+	}
+}
+
+// checkTable asks the Table for its own status, which also covers whether
+// it can send the ball back to this Player.
+func (s *PlayerServer) checkTable(ctx context.Context) error {
+	if s.TableClient == nil || s.tableHealth == nil {
+		return errors.New("table service not connected")
+	}
+	resp, err := s.tableHealth.Check(ctx, &healthpb.HealthCheckRequest{Service: pb.TableService_ServiceDesc.ServiceName})
+	if err != nil {
+		return err
+	}
+	if resp.Status != healthpb.HealthCheckResponse_SERVING {
+		return fmt.Errorf("table service is %s", resp.Status)
+	}
+	return nil
+}
+
+func (s *TableServer) healthChecks() []healthCheck {
+	return []healthCheck{
+		{name: "pingpong.player", check: func(ctx context.Context) error {
+			if s.PlayerClient == nil {
+				return errors.New("player service not connected")
+			}
+			_, err := s.PlayerClient.IsGameActive(ctx, &emptypb.Empty{})
+			return err
+		}},
+	}
+}
+
+func (c healthCheck) run() error {
+	ctx, cancel := context.WithTimeout(context.Background(), healthCheckTimeout)
+	defer cancel()
+	return c.check(ctx)
+}
+
+func watchHealth(hs *health.Server, service string, checks []healthCheck, logger *slog.Logger) {
+	for {
+		runHealthChecks(hs, service, checks, logger)
+		time.Sleep(HealthCheckInterval)
+	}
+}
+
+func runHealthChecks(hs *health.Server, service string, checks []healthCheck, logger *slog.Logger) {
+	overall := healthpb.HealthCheckResponse_SERVING
+
+	for _, c := range checks {
+		err := c.run()
+		status := healthpb.HealthCheckResponse_SERVING
+		if err != nil {
+			logger.Warn("⚠️ Health check failed", "check", c.name, "err", err)
+			status = healthpb.HealthCheckResponse_NOT_SERVING
+			overall = healthpb.HealthCheckResponse_NOT_SERVING
+		}
+		hs.SetServingStatus(c.name, status)
+	}
+
+	hs.SetServingStatus(service, overall)
+	hs.SetServingStatus("", overall)
+}
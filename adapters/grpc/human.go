@@ -0,0 +1,145 @@
+package grpc
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"pingpong/domain"
+	pb "pingpong/proto"
+)
+
+// humanShots hands a human player's shot from HitBall to the turn that is
+// waiting for it. At most one shot is pending at a time.
+type humanShots struct {
+	mu       sync.Mutex
+	pending  chan domain.Shot
+	received int
+	deadline time.Time
+	window   time.Duration
+}
+
+func (h *humanShots) open(received int, window time.Duration) (chan domain.Shot, time.Time) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.pending = make(chan domain.Shot, 1)
+	h.received = received
+	h.window = window
+	h.deadline = time.Now().Add(window)
+	return h.pending, h.deadline
+}
+
+// close stops accepting shots; once it returns nothing more is sent on the
+// channel handed out by open.
+func (h *humanShots) close() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.pending = nil
+}
+
+// submit delivers a shot and returns the power the ball was hit back with.
+// A swing in the last third of the window is late.
+func (h *humanShots) submit(power domain.ShotPower, placement domain.ShotPlacement) (domain.Shot, int, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.pending == nil || time.Now().After(h.deadline) {
+		return domain.Shot{}, 0, domain.ErrNoShotPending
+	}
+	shot := domain.Shot{
+		Power:     power,
+		Placement: placement,
+		Late:      time.Until(h.deadline) < h.window/3,
+	}
+	h.pending <- shot
+	h.pending = nil
+	return shot, shot.ReturnPower(h.received), nil
+}
+
+// playHumanTurn asks the human controlling Player A for a shot and plays it,
+// or gives the point to Player B when none arrives in time.
+func (s *PlayerServer) playHumanTurn(ctx context.Context, turn int, receivedPower int) {
+	ctx, span := s.startHit(ctx, "A", turn, receivedPower)
+	defer span.End()
+	s.pause.wait(ctx)
+
+	shots, deadline := s.shots.open(receivedPower, s.HumanShotWindow)
+	match, _ := s.current()
+	s.updates.publish(shotRequestedUpdate(match, turn, receivedPower, deadline))
+	playerLog.InfoContext(ctx, "🙋 Waiting for Player A to hit the ball", "window", s.HumanShotWindow, "power", receivedPower)
+
+	var shot domain.Shot
+	select {
+	case shot = <-shots:
+	case <-time.After(time.Until(deadline)):
+		s.shots.close()
+		select {
+		case shot = <-shots: // got in just before the deadline
+		default:
+			if !s.inState(matchActive) {
+				return
+			}
+			playerLog.InfoContext(ctx, "⌛ Player A missed the ball")
+			s.logTurn(ctx, turn, "A", receivedPower, 0)
+			s.humanLoses(ctx, domain.ReasonMissed)
+			return
+		}
+	}
+
+	if !s.inState(matchActive) {
+		return // aborted while waiting for the shot
+	}
+	returnPower := shot.ReturnPower(receivedPower)
+	s.logTurn(ctx, turn, "A", receivedPower, returnPower)
+	span.SetAttributes(attribute.Int("ball.return_power", returnPower))
+	if returnPower > domain.MaxBallPower {
+		playerLog.InfoContext(ctx, "💥 Player A hit the ball long", "return_power", returnPower)
+		s.humanLoses(ctx, domain.ReasonOut)
+		return
+	}
+
+	playerLog.DebugContext(ctx, "🎾 Player A returns the ball", "return_power", returnPower, "human", true)
+	s.returnBall(ctx, "A", returnPower)
+}
+
+func (s *PlayerServer) humanLoses(ctx context.Context, reason string) {
+	match, ok := s.endMatch("B")
+	if !ok {
+		return
+	}
+	s.saveMatchResult(ctx, match, reason)
+	playerLog.InfoContext(ctx, "🏁 Match ended", "winner", match.Winner)
+}
+
+func (s *PlayerServer) HitBall(ctx context.Context, req *pb.HitBallRequest) (*pb.HitBallResponse, error) {
+	shot, returnPower, err := s.shots.submit(domain.ShotPower(req.Power), domain.ShotPlacement(req.Placement))
+	if err != nil {
+		return nil, toStatus(err, "cannot hit the ball", playerResourceType, "A")
+	}
+
+	playerLog.InfoContext(s.withMatch(ctx), "🙋 Player A swings", "power", req.Power.String(), "placement", req.Placement.String(), "late", shot.Late)
+	return &pb.HitBallResponse{
+		ReturnPower: int32(returnPower),
+		Out:         returnPower > domain.MaxBallPower,
+	}, nil
+}
+
+func shotRequestedUpdate(match domain.Match, turnNumber int, receivedPower int, deadline time.Time) *pb.MatchUpdate {
+	return &pb.MatchUpdate{
+		Kind:        pb.MatchUpdate_SHOT_REQUESTED,
+		MatchId:     int32(match.ID),
+		MatchNumber: int32(match.MatchNumber),
+		Time:        timestamppb.Now(),
+		Turn: &pb.Turn{
+			TurnNumber:  int32(turnNumber),
+			Player:      "A",
+			BallPower:   int32(receivedPower),
+			MatchNumber: int32(match.MatchNumber),
+		},
+		Deadline: timestamppb.New(deadline),
+	}
+}
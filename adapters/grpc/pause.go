@@ -0,0 +1,92 @@
+package grpc
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"pingpong/domain"
+	pb "pingpong/proto"
+)
+
+// pauseGate holds the ball between hits while a match is paused. The player
+// that is about to hit waits on it before sending the ball to the table.
+type pauseGate struct {
+	mu      sync.Mutex
+	resumed chan struct{} // non-nil while paused
+}
+
+// set pauses or resumes and reports whether that changed anything.
+func (g *pauseGate) set(paused bool) bool {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if paused == (g.resumed != nil) {
+		return false
+	}
+	if paused {
+		g.resumed = make(chan struct{})
+	} else {
+		close(g.resumed)
+		g.resumed = nil
+	}
+	return true
+}
+
+func (g *pauseGate) paused() bool {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	return g.resumed != nil
+}
+
+func (g *pauseGate) wait(ctx context.Context) {
+	g.mu.Lock()
+	resumed := g.resumed
+	g.mu.Unlock()
+
+	if resumed != nil {
+		playerLog.InfoContext(ctx, "⏸️ Match paused, holding the ball")
+		<-resumed
+	}
+}
+
+func (s *PlayerServer) PauseMatch(ctx context.Context, req *pb.PauseMatchRequest) (*pb.PauseMatchResponse, error) {
+	s.matchesMutex.Lock()
+	active, match, turn := s.state == matchActive, s.currentMatch, s.turnCounter
+	s.matchesMutex.Unlock()
+	if !active {
+		return nil, toStatus(domain.ErrNoActiveMatch, "cannot pause", matchResourceType, "")
+	}
+
+	res := &pb.PauseMatchResponse{MatchId: int32(match.ID), Paused: req.Paused}
+	if !s.pause.set(req.Paused) {
+		return res, nil
+	}
+
+	eventType := domain.EventMatchResumed
+	if req.Paused {
+		eventType = domain.EventMatchPaused
+		playerLog.InfoContext(ctx, "⏸️ Match paused")
+	} else {
+		playerLog.InfoContext(ctx, "▶️ Match resumed")
+	}
+	s.recordEvent(ctx, eventType, domain.EventPayload{TurnNumber: turn})
+	s.updates.publish(pauseUpdate(match, req.Paused))
+
+	return res, nil
+}
+
+func pauseUpdate(match domain.Match, paused bool) *pb.MatchUpdate {
+	kind := pb.MatchUpdate_RESUMED
+	if paused {
+		kind = pb.MatchUpdate_PAUSED
+	}
+	return &pb.MatchUpdate{
+		Kind:        kind,
+		MatchId:     int32(match.ID),
+		MatchNumber: int32(match.MatchNumber),
+		Time:        timestamppb.New(time.Now()),
+	}
+}
@@ -0,0 +1,174 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"pingpong/domain"
+	pb "pingpong/proto"
+)
+
+type RecoveryPolicy string
+
+const (
+	RecoveryResume RecoveryPolicy = "resume"
+	RecoveryAbort  RecoveryPolicy = "abort"
+)
+
+func ParseRecoveryPolicy(value string) (RecoveryPolicy, error) {
+	switch policy := RecoveryPolicy(value); policy {
+	case RecoveryResume, RecoveryAbort:
+		return policy, nil
+	default:
+		return "", fmt.Errorf("unknown recovery policy %q (expected %q or %q)", value, RecoveryResume, RecoveryAbort)
+	}
+}
+
+type RecoveryReport struct {
+	Policy           RecoveryPolicy
+	MatchNumberCount int
+	Resumed          []int
+	Aborted          []int
+	Failed           map[int]error
+}
+
+func (r RecoveryReport) Log() {
+	playerLog.Info("🩹 Recovery report", "policy", r.Policy, "match_number_count", r.MatchNumberCount)
+	if len(r.Resumed) == 0 && len(r.Aborted) == 0 && len(r.Failed) == 0 {
+		playerLog.Info("🩹 No unfinished matches found")
+		return
+	}
+	for _, id := range r.Resumed {
+		playerLog.Info("▶️ Resumed match", "match_id", id)
+	}
+	for _, id := range r.Aborted {
+		playerLog.Info("⏹️ Aborted match", "match_id", id)
+	}
+	for id, err := range r.Failed {
+		playerLog.Error("❌ Failed to recover match", "match_id", id, "err", err)
+	}
+}
+
+// RecoverMatches restores the match counter from storage and deals with the
+// matches that were still in progress when the server went down. Only one
+// match can be active at a time, so with RecoveryResume the most recent one is
+// resumed and any older ones are aborted.
+func (s *PlayerServer) RecoverMatches(ctx context.Context, policy RecoveryPolicy) (RecoveryReport, error) {
+	report := RecoveryReport{Policy: policy, Failed: map[int]error{}}
+
+	matchNumber, err := s.matchService.GetMaxMatchNumber(ctx)
+	if err != nil {
+		return report, err
+	}
+	s.matchNumberCount = matchNumber
+	report.MatchNumberCount = matchNumber
+
+	unfinished, err := s.matchService.GetUnfinishedMatches(ctx)
+	if err != nil {
+		return report, err
+	}
+
+	for i, match := range unfinished {
+		if policy == RecoveryResume && i == len(unfinished)-1 {
+			if err := s.resumeMatch(ctx, match); err != nil {
+				report.Failed[match.ID] = err
+				continue
+			}
+			report.Resumed = append(report.Resumed, match.ID)
+			continue
+		}
+
+		match.EndTime = time.Now()
+		match.Winner = domain.WinnerAborted
+		_, err := s.matchService.RecordEvent(ctx, domain.Event{
+			MatchID: match.ID,
+			Type:    domain.EventMatchFinished,
+			Time:    match.EndTime,
+			Payload: domain.EventPayload{
+				MatchNumber: match.MatchNumber,
+				Winner:      domain.WinnerAborted,
+				Reason:      domain.ReasonRecovery,
+			},
+		})
+		if err != nil {
+			report.Failed[match.ID] = err
+			continue
+		}
+		if s.Metrics != nil {
+			s.Metrics.MatchFinished(match, domain.ReasonRecovery)
+		}
+		report.Aborted = append(report.Aborted, match.ID)
+	}
+
+	return report, nil
+}
+
+func (s *PlayerServer) resumeMatch(ctx context.Context, match domain.Match) error {
+	s.matchesMutex.Lock()
+	if s.state != matchIdle {
+		s.matchesMutex.Unlock()
+		return domain.ErrMatchInProgress
+	}
+	s.currentMatch = match
+	s.turnCounter = 0
+	s.routineID = fmt.Sprintf("match-%d-%s", match.MatchNumber, match.StartTime.Format("20060102150405"))
+	if len(match.Turns) > 0 {
+		last := match.Turns[len(match.Turns)-1]
+		s.turnCounter = last.TurnNumber
+		s.routineID = last.RoutineID
+	}
+	s.state = matchActive
+	s.matchCtx, s.cancelMatch = context.WithCancel(context.Background())
+	ctx = context.WithoutCancel(s.withMatchLocked(s.startMatchSpan(ctx, true)))
+	s.watchMatch(match.MatchNumber)
+	s.matchesMutex.Unlock()
+	ctx, cancel := s.bindToMatch(ctx)
+	// Counters start from zero in this process, so the resumed match counts
+	// as started here, as it will count as finished.
+	if s.Metrics != nil {
+		s.Metrics.MatchStarted()
+	}
+
+	playerLog.InfoContext(ctx, "▶️ Resuming match", "match_number", match.MatchNumber)
+
+	go func() {
+		defer cancel()
+		if len(match.Turns) == 0 {
+			_, err := s.TableClient.StartGame(ctx, &pb.StartGameRequest{
+				GameRules: DomainGameRulesToProto(s.matchRules()),
+			})
+			if err != nil {
+				playerLog.ErrorContext(ctx, "❌ Failed to restart game on Table", "err", err)
+			}
+			return
+		}
+
+		last := match.Turns[len(match.Turns)-1]
+		power := s.resumedReturnPower(last)
+		playerLog.InfoContext(ctx, "📤 Replaying last ball", "from", last.Player, "power", power)
+		_, err := s.TableClient.ReceiveBall(ctx, &pb.ReceiveBallRequest{
+			BallPower:  int32(power),
+			FromPlayer: last.Player,
+		})
+		if err != nil {
+			playerLog.ErrorContext(ctx, "❌ Failed to resume rally on Table", "err", err)
+		}
+	}()
+	return nil
+}
+
+// resumedReturnPower is the power the last ball of a resumed match was sent
+// back with. Matches recorded before events did not keep it, so for those the
+// bot plays the shot again under the current rules.
+func (s *PlayerServer) resumedReturnPower(last domain.Turn) int {
+	if last.ReturnPower > 0 {
+		return last.ReturnPower
+	}
+
+	rules := s.matchRules()
+	if last.Player == "A" {
+		return last.BallPower * rules.ReturnPercentA.Pick(time.Now().UnixNano()) / 100
+	}
+	return rules.ReturnPowerB.Pick(time.Now().UnixNano())
+}
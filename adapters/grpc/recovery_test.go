@@ -0,0 +1,93 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+
+	"pingpong/domain"
+	"pingpong/ports"
+	pb "pingpong/proto"
+)
+
+type recoveryService struct {
+	ports.MatchService
+	unfinished []domain.Match
+	events     []domain.Event
+}
+
+func (s *recoveryService) GetMaxMatchNumber(ctx context.Context) (int, error) {
+	return len(s.unfinished), nil
+}
+
+func (s *recoveryService) GetUnfinishedMatches(ctx context.Context) ([]domain.Match, error) {
+	return s.unfinished, nil
+}
+
+func (s *recoveryService) RecordEvent(ctx context.Context, event domain.Event) (domain.Event, error) {
+	s.events = append(s.events, event)
+	return event, nil
+}
+
+type recoveryMetrics struct {
+	started  int
+	finished map[string]int
+}
+
+func (m *recoveryMetrics) MatchStarted()                    { m.started++ }
+func (m *recoveryMetrics) BallHit(player string, power int) {}
+func (m *recoveryMetrics) MatchFinished(match domain.Match, reason string) {
+	m.finished[match.Winner+"/"+reason]++
+}
+
+type recordingTable struct {
+	pb.TableServiceClient
+	balls chan *pb.ReceiveBallRequest
+}
+
+func (t recordingTable) ReceiveBall(ctx context.Context, req *pb.ReceiveBallRequest, opts ...grpc.CallOption) (*pb.ReceiveBallResponse, error) {
+	t.balls <- req
+	return &pb.ReceiveBallResponse{}, nil
+}
+
+func TestRecoverMatchesResumesWithReturnPower(t *testing.T) {
+	start := time.Now().Add(-time.Minute)
+	svc := &recoveryService{unfinished: []domain.Match{
+		{ID: 1, MatchNumber: 1, StartTime: start},
+		{ID: 2, MatchNumber: 2, StartTime: start, Turns: []domain.Turn{
+			{TurnNumber: 1, Player: "A", BallPower: 80, ReturnPower: 60, RoutineID: "match-2"},
+			{TurnNumber: 2, Player: "B", BallPower: 60, ReturnPower: 75, RoutineID: "match-2"},
+		}},
+	}}
+	metrics := &recoveryMetrics{finished: map[string]int{}}
+	table := recordingTable{balls: make(chan *pb.ReceiveBallRequest, 1)}
+	s := NewPlayerServer(svc, nil, nil)
+	s.TableClient = table
+	s.Metrics = metrics
+
+	report, err := s.RecoverMatches(context.Background(), RecoveryResume)
+	if err != nil {
+		t.Fatalf("RecoverMatches: %v", err)
+	}
+	if len(report.Aborted) != 1 || report.Aborted[0] != 1 || len(report.Resumed) != 1 || report.Resumed[0] != 2 {
+		t.Fatalf("aborted %v and resumed %v, want [1] and [2]", report.Aborted, report.Resumed)
+	}
+
+	select {
+	case ball := <-table.balls:
+		if ball.BallPower != 75 || ball.FromPlayer != "B" {
+			t.Errorf("resumed with %d from %s, want 75 from B", ball.BallPower, ball.FromPlayer)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("resumed match sent no ball to the Table")
+	}
+
+	if metrics.started != 1 {
+		t.Errorf("%d matches counted as started, want 1", metrics.started)
+	}
+	if n := metrics.finished[domain.WinnerAborted+"/"+domain.ReasonRecovery]; n != 1 || len(metrics.finished) != 1 {
+		t.Errorf("finished matches counted as %v, want one aborted by recovery", metrics.finished)
+	}
+}
@@ -0,0 +1,93 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"pingpong/domain"
+	pb "pingpong/proto"
+)
+
+const rulesResourceType = "pingpong.GameRules"
+
+// liveRules holds game rules that can be replaced while the server runs.
+// Matches snapshot them when they start, so a change only applies to the
+// next match.
+type liveRules struct {
+	current atomic.Pointer[domain.GameRules]
+}
+
+func newLiveRules() *liveRules {
+	l := &liveRules{}
+	rules := domain.DefaultGameRules()
+	l.current.Store(&rules)
+	return l
+}
+
+func (l *liveRules) load() domain.GameRules {
+	return *l.current.Load()
+}
+
+func (l *liveRules) store(rules domain.GameRules) error {
+	if err := rules.Validate(); err != nil {
+		return fmt.Errorf("%w: %w", domain.ErrInvalidArgument, err)
+	}
+	l.current.Store(&rules)
+	return nil
+}
+
+// Rules returns the rules the next match will be played under.
+func (s *PlayerServer) Rules() domain.GameRules {
+	return s.rules.load()
+}
+
+// SetRules replaces the game rules from the next match on.
+func (s *PlayerServer) SetRules(rules domain.GameRules) error {
+	if err := s.rules.store(rules); err != nil {
+		return err
+	}
+	playerLog.Info("🎛️ Game rules from the next match on", "rules", rules)
+	return nil
+}
+
+// matchRules are the rules of the match in progress. Matches recovered from
+// before rules were stored with them play under the current rules.
+func (s *PlayerServer) matchRules() domain.GameRules {
+	s.matchesMutex.Lock()
+	rules := s.currentMatch.Rules
+	s.matchesMutex.Unlock()
+	if rules != nil {
+		return *rules
+	}
+	return s.rules.load()
+}
+
+func (s *PlayerServer) GetGameRules(ctx context.Context, _ *emptypb.Empty) (*pb.GameRules, error) {
+	return DomainGameRulesToProto(s.Rules()), nil
+}
+
+func (s *PlayerServer) SetGameRules(ctx context.Context, req *pb.GameRules) (*pb.GameRules, error) {
+	rules := ProtoToDomainGameRules(req)
+	if err := s.SetRules(rules); err != nil {
+		return nil, toStatus(err, "cannot set game rules", rulesResourceType, "")
+	}
+	return DomainGameRulesToProto(rules), nil
+}
+
+// Rules returns the rules the Table serves by when a match does not bring
+// its own.
+func (s *TableServer) Rules() domain.GameRules {
+	return s.rules.load()
+}
+
+// SetRules replaces the Table's fallback rules.
+func (s *TableServer) SetRules(rules domain.GameRules) error {
+	if err := s.rules.store(rules); err != nil {
+		return err
+	}
+	tableLog.Info("🎛️ Table game rules", "rules", rules)
+	return nil
+}
@@ -0,0 +1,106 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"pingpong/domain"
+	pb "pingpong/proto"
+)
+
+const scheduleResourceType = "pingpong.Schedule"
+
+// PlayMatch waits for any match in progress to end, then starts one and
+// waits for it to finish. It is how scheduled runs play their matches. A
+// match that times out is aborted, which still counts as played.
+func (s *PlayerServer) PlayMatch(ctx context.Context) error {
+	for {
+		if err := s.waitForMatchEnd(ctx); err != nil {
+			return err
+		}
+		err := s.startMatch(ctx)
+		if err == nil {
+			break
+		}
+		if !errors.Is(err, domain.ErrMatchInProgress) {
+			return err
+		}
+		// Someone else started a match first; wait for it too.
+	}
+	return s.waitForMatchEnd(ctx)
+}
+
+func (s *PlayerServer) waitForMatchEnd(ctx context.Context) error {
+	ticker := time.NewTicker(drainPollInterval)
+	defer ticker.Stop()
+	for !s.inState(matchIdle) {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+	return nil
+}
+
+// errSchedulingDisabled is returned when there is no database to keep
+// schedules in.
+var errSchedulingDisabled = fmt.Errorf("scheduling is disabled: %w", domain.ErrStorageUnavailable)
+
+func (s *PlayerServer) CreateSchedule(ctx context.Context, req *pb.CreateScheduleRequest) (*pb.Schedule, error) {
+	if s.Schedules == nil {
+		return nil, toStatus(errSchedulingDisabled, "cannot create schedule", scheduleResourceType, "")
+	}
+	schedule, err := s.Schedules.CreateSchedule(ctx, domain.Schedule{
+		Name:    req.Name,
+		Cron:    req.Cron,
+		Matches: int(req.Matches),
+	})
+	if err != nil {
+		return nil, toStatus(err, "cannot create schedule", scheduleResourceType, "")
+	}
+	return DomainScheduleToProto(schedule), nil
+}
+
+func (s *PlayerServer) ListSchedules(ctx context.Context, _ *emptypb.Empty) (*pb.ListSchedulesResponse, error) {
+	if s.Schedules == nil {
+		return nil, toStatus(errSchedulingDisabled, "cannot list schedules", scheduleResourceType, "")
+	}
+	schedules, err := s.Schedules.ListSchedules(ctx)
+	if err != nil {
+		return nil, toStatus(err, "cannot list schedules", scheduleResourceType, "")
+	}
+
+	res := &pb.ListSchedulesResponse{Schedules: make([]*pb.Schedule, len(schedules))}
+	for i, schedule := range schedules {
+		res.Schedules[i] = DomainScheduleToProto(schedule)
+	}
+	return res, nil
+}
+
+func (s *PlayerServer) DeleteSchedule(ctx context.Context, req *pb.DeleteScheduleRequest) (*emptypb.Empty, error) {
+	id := strconv.Itoa(int(req.Id))
+	if s.Schedules == nil {
+		return nil, toStatus(errSchedulingDisabled, "cannot delete schedule", scheduleResourceType, id)
+	}
+	if err := s.Schedules.DeleteSchedule(ctx, int(req.Id)); err != nil {
+		return nil, toStatus(err, "cannot delete schedule", scheduleResourceType, id)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func DomainScheduleToProto(schedule domain.Schedule) *pb.Schedule {
+	return &pb.Schedule{
+		Id:         int32(schedule.ID),
+		Name:       schedule.Name,
+		Cron:       schedule.Cron,
+		Matches:    int32(schedule.Matches),
+		CreateTime: timestamppb.New(schedule.CreatedAt),
+	}
+}
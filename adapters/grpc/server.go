@@ -3,389 +3,718 @@ package grpc
 import (
 	"context"
 	"fmt"
-	"log"
-	"net"
-	"os"
+	"slices"
 	"sync"
+	"sync/atomic"
 	"time"
 
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 	"google.golang.org/grpc"
-	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/protobuf/types/known/emptypb"
 
 	"pingpong/domain"
+	"pingpong/logging"
 	"pingpong/ports"
 	pb "pingpong/proto"
 )
 
 const (
-	PlayersPort = "8888"
-	TablePort   = "8889"
+	DefaultListLimit = 50
+	// DefaultMatchTimeout is far longer than any match takes, even one
+	// played by a human.
+	DefaultMatchTimeout = 5 * time.Minute
+)
+
+var (
+	playerLog = logging.For(logging.ComponentPlayer)
+	tableLog  = logging.For(logging.ComponentTable)
 )
 
 type PlayerServer struct {
 	pb.UnimplementedPlayerServiceServer
 	matchService     ports.MatchService
+	turnSink         ports.TurnSink
 	currentMatch     domain.Match
 	turnCounter      int
 	matchNumberCount int
 	routineID        string
+	matchSpan        trace.Span
 	matchesMutex     sync.Mutex
 	TableClient      pb.TableServiceClient
-	gameActive       bool
+	tableHealth      healthpb.HealthClient
+	Notifier         ports.MatchNotifier
+	Metrics          ports.MatchMetrics
+	// Schedules is nil when matches cannot be scheduled.
+	Schedules ports.ScheduleService
+	// HumanShotWindow, when set, hands Player A to a human who has this long
+	// to answer each ball through HitBall.
+	HumanShotWindow time.Duration
+	// MatchTimeout aborts matches still in play after this long, such as
+	// one whose ball was lost to an unreachable Table.
+	MatchTimeout time.Duration
+	rules        *liveRules
+	state        matchState
+	matchCtx     context.Context
+	cancelMatch  context.CancelFunc
+	updates      updateBroadcaster
+	pause        pauseGate
+	shots        humanShots
+	draining     atomic.Bool
 }
 
-func NewPlayerServer(matchService ports.MatchService, tableConn *grpc.ClientConn) *PlayerServer {
-	return &PlayerServer{
+func NewPlayerServer(matchService ports.MatchService, turnSink ports.TurnSink, tableConn *grpc.ClientConn) *PlayerServer {
+	s := &PlayerServer{
 		matchService: matchService,
-		TableClient:  pb.NewTableServiceClient(tableConn),
+		turnSink:     turnSink,
+		MatchTimeout: DefaultMatchTimeout,
+		rules:        newLiveRules(),
+	}
+	s.matchCtx, s.cancelMatch = context.WithCancel(context.Background())
+	if tableConn != nil {
+		s.TableClient = pb.NewTableServiceClient(tableConn)
+		s.tableHealth = healthpb.NewHealthClient(tableConn)
+	}
+	return s
+}
+
+// matchState is where the server is in the life of a match: active while
+// the ball is in play, ending once the winner is decided and until the result
+// is saved, then idle again. It is guarded by matchesMutex, as are
+// currentMatch, matchSpan and matchCtx, which is cancelled when the match
+// ends.
+type matchState int
+
+const (
+	matchIdle matchState = iota
+	matchActive
+	matchEnding
+)
+
+func (s *PlayerServer) inState(state matchState) bool {
+	s.matchesMutex.Lock()
+	defer s.matchesMutex.Unlock()
+	return s.state == state
+}
+
+// endMatch decides the winner of the match in progress and returns a copy of
+// it to save. Only the first of the paths racing to end a match, such as the
+// last hit and a shutdown, gets ok; the others must leave the match alone.
+func (s *PlayerServer) endMatch(winner string) (match domain.Match, ok bool) {
+	s.matchesMutex.Lock()
+	defer s.matchesMutex.Unlock()
+	return s.endMatchLocked(winner)
+}
+
+func (s *PlayerServer) endMatchLocked(winner string) (match domain.Match, ok bool) {
+	if s.state != matchActive {
+		return domain.Match{}, false
 	}
+
+	s.state = matchEnding
+	s.cancelMatch()
+	s.currentMatch.EndTime = time.Now()
+	s.currentMatch.Winner = winner
+	match = s.currentMatch
+	match.Turns = slices.Clone(match.Turns)
+	return match, true
 }
 
-func (s *PlayerServer) initMatch() {
+// initMatch resets the server for a new match and returns ctx with the
+// match's span. It fails, leaving the server alone, while another match is
+// in progress or the server is shutting down.
+func (s *PlayerServer) initMatch(ctx context.Context) (context.Context, error) {
+	s.matchesMutex.Lock()
+	if s.draining.Load() {
+		s.matchesMutex.Unlock()
+		return ctx, domain.ErrShuttingDown
+	}
+	if s.state != matchIdle {
+		s.matchesMutex.Unlock()
+		return ctx, domain.ErrMatchInProgress
+	}
 	s.matchNumberCount++
+	rules := s.rules.load()
 	s.currentMatch = domain.Match{
 		ID:          0,
 		MatchNumber: s.matchNumberCount,
 		StartTime:   time.Now(),
 		Turns:       []domain.Turn{},
+		Rules:       &rules,
 	}
 	s.turnCounter = 0
 	s.routineID = fmt.Sprintf("match-%d-%s", s.matchNumberCount, time.Now().Format("20060102150405"))
-	s.gameActive = true
-	log.Printf("🆕 New match initialized: Match #%d, RoutineID: %s", s.matchNumberCount, s.routineID)
+	s.state = matchActive
+	s.matchCtx, s.cancelMatch = context.WithCancel(context.Background())
+	ctx = s.withMatchLocked(s.startMatchSpan(ctx, false))
+	s.watchMatch(s.matchNumberCount)
+	match, routineID := s.currentMatch, s.routineID
+	s.matchesMutex.Unlock()
+
+	s.pause.set(false)
+	if s.Metrics != nil {
+		s.Metrics.MatchStarted()
+	}
+
+	event, err := s.matchService.RecordEvent(ctx, domain.Event{
+		Type: domain.EventMatchStarted,
+		Time: match.StartTime,
+		Payload: domain.EventPayload{
+			MatchNumber: match.MatchNumber,
+			RoutineID:   routineID,
+			Rules:       match.Rules,
+		},
+	})
+	if err != nil {
+		playerLog.WarnContext(ctx, "⚠️ Failed to persist match start, turns will be saved at match end", "err", err)
+	} else {
+		match.ID = event.MatchID
+		s.matchesMutex.Lock()
+		if s.currentMatch.MatchNumber == match.MatchNumber {
+			s.currentMatch.ID = match.ID
+		}
+		s.matchesMutex.Unlock()
+		ctx = s.withMatch(ctx)
+	}
+	playerLog.InfoContext(ctx, "🆕 New match started", "match_number", match.MatchNumber)
+
+	s.updates.publish(matchStartedUpdate(match))
+	return ctx, nil
+}
+
+// current returns the match in progress, without its turns, and the number of
+// its last turn.
+func (s *PlayerServer) current() (match domain.Match, turn int) {
+	s.matchesMutex.Lock()
+	defer s.matchesMutex.Unlock()
+	match = s.currentMatch
+	match.Turns = nil
+	return match, s.turnCounter
+}
+
+// nextTurn counts a ball received in the match in progress and returns its
+// turn number. It fails once the match has ended.
+func (s *PlayerServer) nextTurn() (turn int, ok bool) {
+	s.matchesMutex.Lock()
+	defer s.matchesMutex.Unlock()
+	if s.state != matchActive {
+		return 0, false
+	}
+	s.turnCounter++
+	return s.turnCounter, true
+}
+
+// withMatch returns ctx with the current match and turn, so that the lines
+// logged with it, here and in the Table, can be told apart by match.
+func (s *PlayerServer) withMatch(ctx context.Context) context.Context {
+	s.matchesMutex.Lock()
+	defer s.matchesMutex.Unlock()
+	return s.withMatchLocked(ctx)
+}
+
+func (s *PlayerServer) withMatchLocked(ctx context.Context) context.Context {
+	return logging.WithMatch(ctx, s.currentMatch.ID, s.routineID, s.turnCounter)
+}
+
+// recordEvent adds an event to the current match's history. Matches whose
+// start could not be persisted have no ID: their events are only published,
+// and the match is saved whole when it ends.
+func (s *PlayerServer) recordEvent(ctx context.Context, eventType domain.EventType, payload domain.EventPayload) {
+	event := s.newEvent(eventType, payload)
+	_, err := s.matchService.RecordEvent(ctx, event)
+	if err != nil && event.MatchID != 0 {
+		playerLog.ErrorContext(ctx, "❌ Error recording event", "event", eventType, "err", err)
+	}
+}
+
+func (s *PlayerServer) newEvent(eventType domain.EventType, payload domain.EventPayload) domain.Event {
+	s.matchesMutex.Lock()
+	defer s.matchesMutex.Unlock()
+	payload.MatchNumber = s.currentMatch.MatchNumber
+	payload.RoutineID = s.routineID
+	return domain.Event{
+		MatchID: s.currentMatch.ID,
+		Type:    eventType,
+		Time:    time.Now(),
+		Payload: payload,
+	}
 }
 
-func (s *PlayerServer) logTurn(player string, ballPower int) {
+func (s *PlayerServer) logTurn(ctx context.Context, turnNumber int, player string, ballPower int, returnPower int) {
+	s.matchesMutex.Lock()
 	turn := domain.Turn{
-		TurnNumber:  s.turnCounter,
+		TurnNumber:  turnNumber,
 		Time:        time.Now(),
 		Player:      player,
 		BallPower:   ballPower,
 		RoutineID:   s.routineID,
 		MatchNumber: s.currentMatch.MatchNumber,
+		ReturnPower: returnPower,
 	}
-
-	s.matchesMutex.Lock()
 	s.currentMatch.Turns = append(s.currentMatch.Turns, turn)
+	match := s.currentMatch
 	s.matchesMutex.Unlock()
+	if s.Metrics != nil {
+		s.Metrics.BallHit(player, ballPower)
+	}
 
-	log.Printf("🏓 Turn #%d: Player %s hit with power %d (Match #%d, Routine: %s)",
-		turn.TurnNumber, player, ballPower, turn.MatchNumber, s.routineID)
+	playerLog.InfoContext(ctx, "🏓 Ball hit", "power", ballPower)
 
-	f, err := os.OpenFile("match_log.csv", os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
-	if err != nil {
-		log.Printf("❌ Error opening log file: %v", err)
-		return
+	eventType := domain.EventBallHit
+	if turn.TurnNumber == 1 {
+		eventType = domain.EventBallServed
 	}
-	defer f.Close()
-
-	logLine := fmt.Sprintf("%s,%d,%s,%d,%s,%d\n",
-		turn.Time.Format(time.RFC3339),
-		turn.TurnNumber,
-		turn.Player,
-		turn.BallPower,
-		turn.RoutineID,
-		turn.MatchNumber)
+	s.recordEvent(ctx, eventType, domain.EventPayload{
+		TurnNumber:  turn.TurnNumber,
+		Player:      player,
+		BallPower:   ballPower,
+		ReturnPower: returnPower,
+	})
 
-	if _, err := f.WriteString(logLine); err != nil {
-		log.Printf("❌ Error writing to log file: %v", err)
+	if err := s.turnSink.WriteTurn(turn); err != nil {
+		playerLog.ErrorContext(ctx, "❌ Error writing to turn log", "err", err)
 	}
+
+	s.updates.publish(turnUpdate(match, turn))
 }
 
 func (s *PlayerServer) StartNewMatch(ctx context.Context, req *pb.NewMatchRequest) (*pb.NewMatchResponse, error) {
-	log.Println("🎮 Starting new match...")
-	s.initMatch()
+	if err := s.startMatch(ctx); err != nil {
+		return nil, toStatus(err, "cannot start a new match", matchResourceType, "")
+	}
+	return &pb.NewMatchResponse{Message: "New match started"}, nil
+}
+
+// startMatch begins a new match, traced as a child of ctx, and asks the
+// Table to serve.
+func (s *PlayerServer) startMatch(ctx context.Context) error {
+	playerLog.DebugContext(ctx, "🎮 Starting new match...")
+	ctx, err := s.initMatch(ctx)
+	if err != nil {
+		return err
+	}
+	ctx, cancel := s.bindToMatch(context.WithoutCancel(ctx))
+	rules := DomainGameRulesToProto(s.matchRules())
 
 	go func() {
+		defer cancel()
 		time.Sleep(100 * time.Millisecond)
-		log.Printf("📤 Sending start game request to table")
+		playerLog.DebugContext(ctx, "📤 Sending start game request to table")
 
-		_, err := s.TableClient.StartGame(context.Background(), &pb.StartGameRequest{})
-		if err != nil {
-			log.Printf("❌ Failed to notify Table: %v", err)
+		_, err := s.TableClient.StartGame(ctx, &pb.StartGameRequest{GameRules: rules})
+		if ctx.Err() != nil {
+			playerLog.DebugContext(ctx, "🚫 Match ended before the Table served")
+		} else if err != nil {
+			playerLog.ErrorContext(ctx, "❌ Failed to notify Table", "err", err)
 		} else {
-			log.Printf("✅ Successfully notified Table")
+			playerLog.DebugContext(ctx, "✅ Successfully notified Table")
 		}
 	}()
-
-	return &pb.NewMatchResponse{Message: "New match started"}, nil
+	return nil
 }
 
 func (s *PlayerServer) PlayerAPing(ctx context.Context, req *pb.PingRequest) (*pb.PingResponse, error) {
-	log.Println("📥 Player A received ping")
+	playerLog.DebugContext(ctx, "📥 Player A received ping")
+
+	turn, ok := s.nextTurn()
+	if !ok {
+		playerLog.DebugContext(ctx, "🚫 Match already ended. Ignoring ping.")
+		return &pb.PingResponse{}, nil
+	}
 
-	s.turnCounter++
 	receivedPower := int(req.BallPower)
-	s.logTurn("A", receivedPower)
+	if s.HumanShotWindow > 0 {
+		go s.playHumanTurn(context.WithoutCancel(ctx), turn, receivedPower)
+		return &pb.PingResponse{}, nil
+	}
 
-	returnPower := int(float64(receivedPower) * (70 + float64(time.Now().UnixNano()%20)) / 100)
-	log.Printf("🎾 Player A returning with power: %d (70-90%% of %d)", returnPower, receivedPower)
+	ctx, span := s.startHit(ctx, "A", turn, receivedPower)
+	defer span.End()
+	percent := s.matchRules().ReturnPercentA
+	returnPower := receivedPower * percent.Pick(time.Now().UnixNano()) / 100
+	s.logTurn(ctx, turn, "A", receivedPower, returnPower)
+	span.SetAttributes(attribute.Int("ball.return_power", returnPower))
 
-	go func() {
-		log.Printf("📤 Player A sending to table with ball power: %d", returnPower)
+	playerLog.DebugContext(ctx, "🎾 Player A returns the ball", "return_power", returnPower,
+		"min_percent", percent.Min, "max_percent", percent.Max)
 
-		_, err := s.TableClient.ReceiveBall(context.Background(), &pb.ReceiveBallRequest{
-			BallPower:  int32(returnPower),
-			FromPlayer: "A",
-		})
-		if err != nil {
-			log.Printf("❌ Failed to ping table: %v", err)
-			return
-		}
-		log.Printf("✅ Successfully sent ping to table")
-	}()
+	go s.returnBall(context.WithoutCancel(ctx), "A", returnPower)
 
 	return &pb.PingResponse{}, nil
 }
 
-
 func (s *PlayerServer) PlayerBPing(ctx context.Context, req *pb.PingRequest) (*pb.PingResponse, error) {
-	log.Println("📥 Player B received ping")
+	playerLog.DebugContext(ctx, "📥 Player B received ping")
 
-	if !s.gameActive {
-		log.Println("🚫 Match already ended. Ignoring ping.")
+	turn, ok := s.nextTurn()
+	if !ok {
+		playerLog.DebugContext(ctx, "🚫 Match already ended. Ignoring ping.")
 		return &pb.PingResponse{}, nil
 	}
 
-	s.turnCounter++
 	receivedPower := int(req.BallPower)
-	s.logTurn("B", receivedPower)
-
-	returnPower := 50 + int(time.Now().UnixNano()%50)
-	log.Printf("🎾 Player B generated return power: %d (vs received power: %d)", returnPower, receivedPower)
-
-	if s.turnCounter > 10 {
-		log.Printf("⚠️ Exceeded 10 turns! Evaluating winner by power...")
+	ctx, span := s.startHit(ctx, "B", turn, receivedPower)
+	defer span.End()
+	rules := s.matchRules()
+	returnPower := rules.ReturnPowerB.Pick(time.Now().UnixNano())
+	s.logTurn(ctx, turn, "B", receivedPower, returnPower)
+	span.SetAttributes(attribute.Int("ball.return_power", returnPower))
 
-		s.currentMatch.EndTime = time.Now()
-		s.gameActive = false
+	playerLog.DebugContext(ctx, "🎾 Player B generated return power", "return_power", returnPower)
 
+	if turn > rules.TurnLimit {
+		winner := "Draw"
 		if returnPower > receivedPower {
-			s.currentMatch.Winner = "B"
-			log.Printf("🏁 Match ended by rule (10 turns). Winner: Player B")
+			winner = "B"
 		} else if returnPower < receivedPower {
-			s.currentMatch.Winner = "A"
-			log.Printf("🏁 Match ended by rule (10 turns). Winner: Player A")
-		} else {
-			s.currentMatch.Winner = "Draw"
-			log.Printf("🤝 Match ended in a draw (equal power)")
+			winner = "A"
 		}
-
-		err := s.matchService.SaveMatch(context.Background(), s.currentMatch)
-		if err != nil {
-			log.Printf("❌ Error saving to MySQL: %v", err)
-		} else {
-			log.Println("✅ Match saved to MySQL successfully")
+		match, ok := s.endMatch(winner)
+		if !ok {
+			return &pb.PingResponse{}, nil
 		}
+		playerLog.InfoContext(ctx, "🏁 Match ended by the turn limit, the harder hit wins",
+			"turn_limit", rules.TurnLimit, "winner", winner, "return_power", returnPower)
+
+		s.saveMatchResult(ctx, match, domain.ReasonTurnLimit)
 
 		return &pb.PingResponse{}, nil
 	}
 
 	if returnPower > receivedPower {
-		log.Printf("✅ Player B returns the ball (power %d > %d)", returnPower, receivedPower)
-		go func() {
-			log.Printf("📤 Player B sending to table with ball power: %d", returnPower)
-
-			_, err := s.TableClient.ReceiveBall(context.Background(), &pb.ReceiveBallRequest{
-				BallPower:  int32(returnPower),
-				FromPlayer: "B",
-			})
-			if err != nil {
-				log.Printf("❌ Failed to ping table: %v", err)
-				return
-			}
-			log.Printf("✅ Successfully sent ping to table")
-		}()
+		playerLog.DebugContext(ctx, "✅ Player B returns the ball", "return_power", returnPower)
+		go s.returnBall(context.WithoutCancel(ctx), "B", returnPower)
 	} else {
-		log.Printf("❌ Player B lost! (return power %d <= received power %d)", returnPower, receivedPower)
-		s.currentMatch.EndTime = time.Now()
-		s.currentMatch.Winner = "A"
-		s.gameActive = false
+		playerLog.InfoContext(ctx, "❌ Player B lost the rally, return too weak", "return_power", returnPower)
+		match, ok := s.endMatch("A")
+		if !ok {
+			return &pb.PingResponse{}, nil
+		}
 
-		log.Println("Saving final match result to database...")
-		err := s.matchService.SaveMatch(context.Background(), s.currentMatch)
-		if err != nil {
-			log.Printf("❌ Error saving to MySQL: %v", err)
+		s.saveMatchResult(ctx, match, domain.ReasonWeakReturn)
+
+		playerLog.InfoContext(ctx, "🏁 Match ended", "winner", match.Winner)
+	}
+
+	return &pb.PingResponse{}, nil
+}
+
+// bindToMatch returns ctx cancelled once the current match ends, so a call
+// to the Table that hangs, such as while it is unreachable, cannot deliver
+// the ball of an aborted match into a later one.
+func (s *PlayerServer) bindToMatch(ctx context.Context) (context.Context, context.CancelFunc) {
+	s.matchesMutex.Lock()
+	matchCtx := s.matchCtx
+	s.matchesMutex.Unlock()
+
+	ctx, cancel := context.WithCancel(ctx)
+	stop := context.AfterFunc(matchCtx, cancel)
+	return ctx, func() {
+		stop()
+		cancel()
+	}
+}
+
+// returnBall sends the ball back to the table once the match is not paused.
+func (s *PlayerServer) returnBall(ctx context.Context, player string, power int) {
+	ctx, cancel := s.bindToMatch(ctx)
+	defer cancel()
+	s.pause.wait(ctx)
+	playerLog.DebugContext(ctx, "📤 Sending ball to table", "power", power)
+
+	_, err := s.TableClient.ReceiveBall(ctx, &pb.ReceiveBallRequest{
+		BallPower:  int32(power),
+		FromPlayer: player,
+	})
+	if ctx.Err() != nil {
+		playerLog.DebugContext(ctx, "🚫 Match ended before the ball reached the table")
+		return
+	}
+	if err != nil {
+		playerLog.ErrorContext(ctx, "❌ Failed to ping table", "err", err)
+		return
+	}
+	playerLog.DebugContext(ctx, "✅ Successfully sent ping to table")
+}
+
+// saveMatchResult stores the result of match, as returned by endMatch, and
+// closes its trace. A new match can start once it returns. Saving is not
+// cancelled with ctx.
+func (s *PlayerServer) saveMatchResult(ctx context.Context, match domain.Match, reason string) {
+	ctx, span := tracer.Start(context.WithoutCancel(ctx), "SaveMatch", trace.WithAttributes(
+		attribute.Int("match.number", match.MatchNumber),
+		attribute.String("match.winner", match.Winner),
+	))
+	defer s.setState(matchIdle)
+	defer s.endMatchSpan(match, reason)
+	defer span.End()
+
+	s.updates.publish(matchFinishedUpdate(match))
+	if s.Metrics != nil {
+		s.Metrics.MatchFinished(match, reason)
+	}
+
+	if s.Notifier != nil {
+		if err := s.Notifier.NotifyMatchFinished(ctx, match); err != nil {
+			playerLog.ErrorContext(ctx, "❌ Error sending match notifications", "err", err)
+		}
+	}
+
+	if err := s.turnSink.Flush(); err != nil {
+		playerLog.ErrorContext(ctx, "❌ Error flushing turn log", "err", err)
+	}
+
+	if match.ID == 0 {
+		if err := s.matchService.SaveMatch(ctx, match); err != nil {
+			spanError(span, err)
+			playerLog.ErrorContext(ctx, "❌ Error saving match", "err", err)
 		} else {
-			log.Println("✅ Match saved to MySQL successfully")
+			playerLog.InfoContext(ctx, "✅ Match saved", "winner", match.Winner, "reason", reason)
 		}
+		return
+	}
 
-		log.Printf("🏁 Game ended! Winner: Player A (Match #%d)", s.currentMatch.MatchNumber)
+	if match.Winner == "A" || match.Winner == "B" {
+		_, turn := s.current()
+		s.recordEvent(ctx, domain.EventPointAwarded, domain.EventPayload{
+			TurnNumber: turn,
+			Player:     match.Winner,
+			Reason:     reason,
+		})
+	}
+	// The turns seen here go along so that none is lost from the stored match
+	// when appending it failed during the rally.
+	finished := s.newEvent(domain.EventMatchFinished, domain.EventPayload{
+		Winner: match.Winner,
+		Reason: reason,
+	})
+	if _, err := s.matchService.FinishMatch(ctx, finished, match.Turns); err != nil {
+		spanError(span, err)
+		playerLog.ErrorContext(ctx, "❌ Error recording event", "event", finished.Type, "err", err)
+		return
 	}
+	playerLog.InfoContext(ctx, "✅ Match result recorded", "winner", match.Winner, "reason", reason)
+}
 
-	return &pb.PingResponse{}, nil
+func (s *PlayerServer) setState(state matchState) {
+	s.matchesMutex.Lock()
+	defer s.matchesMutex.Unlock()
+	s.state = state
 }
 
 func (s *PlayerServer) GetMatch(ctx context.Context, req *pb.GetMatchRequest) (*pb.Match, error) {
-	log.Println("📊 Request for last match")
+	playerLog.DebugContext(ctx, "📊 Request for last match")
 
 	match, err := s.matchService.GetLastMatch(ctx)
 	if err != nil {
-		log.Printf("❌ No match data available: %v", err)
-		return nil, fmt.Errorf("no match data available: %v", err)
+		playerLog.WarnContext(ctx, "❌ No match data available", "err", err)
+		return nil, toStatus(err, "no match data available", matchResourceType, "")
 	}
 
-	pbMatch := convertDomainMatchToProto(match)
-	log.Printf("✅ Found last match data")
+	pbMatch := DomainMatchToProto(match)
+	playerLog.DebugContext(ctx, "✅ Found last match data", "id", match.ID)
 	return pbMatch, nil
 }
 
 func (s *PlayerServer) GetMatchByID(ctx context.Context, req *pb.GetMatchByIDRequest) (*pb.Match, error) {
 	id := int(req.Id)
-	log.Printf("📊 Request for match with ID: %d", id)
+	playerLog.DebugContext(ctx, "📊 Request for match", "id", id)
 
 	match, err := s.matchService.GetMatchByID(ctx, id)
 	if err != nil {
-		log.Printf("❌ Match not found: %v", err)
-		return nil, fmt.Errorf("match not found: %v", err)
+		playerLog.WarnContext(ctx, "❌ Match not found", "id", id, "err", err)
+		return nil, matchStatus(err, "match not found", id)
 	}
 
-	pbMatch := convertDomainMatchToProto(match)
-	log.Printf("✅ Found match data for ID %d", id)
+	pbMatch := DomainMatchToProto(match)
+	playerLog.DebugContext(ctx, "✅ Found match data", "id", id)
 	return pbMatch, nil
 }
 
-func (s *PlayerServer) TestDB(ctx context.Context, req *pb.TestDBRequest) (*pb.TestDBResponse, error) {
-	log.Println("🧪 Testing database connections...")
+func (s *PlayerServer) ListMatches(ctx context.Context, req *pb.ListMatchesRequest) (*pb.ListMatchesResponse, error) {
+	playerLog.DebugContext(ctx, "📊 Request for match list")
+
+	filter := ProtoToDomainMatchFilter(req)
+	if filter.Limit <= 0 {
+		filter.Limit = DefaultListLimit
+	}
 
-	err := s.matchService.TestConnection(ctx)
+	res := &pb.ListMatchesResponse{}
+	err := s.matchService.StreamMatches(ctx, filter, func(match domain.Match) error {
+		res.Matches = append(res.Matches, DomainMatchToProto(match))
+		return nil
+	})
 	if err != nil {
-		log.Printf("❌ Database test failed: %v", err)
-		return nil, fmt.Errorf("database test failed: %v", err)
+		playerLog.ErrorContext(ctx, "❌ Failed to list matches", "err", err)
+		return nil, toStatus(err, "failed to list matches", matchResourceType, "")
 	}
 
-	return &pb.TestDBResponse{Message: "Database test completed successfully"}, nil
+	playerLog.DebugContext(ctx, "✅ Found matches", "count", len(res.Matches))
+	return res, nil
 }
 
-func (s *PlayerServer) IsGameActive(ctx context.Context, _ *emptypb.Empty) (*pb.IsGameActiveResponse, error) {
-	return &pb.IsGameActiveResponse{
-		Active: s.gameActive,
-	}, nil
+func (s *PlayerServer) SaveMatch(ctx context.Context, req *pb.Match) (*pb.SaveMatchResponse, error) {
+	playerLog.DebugContext(ctx, "💾 Request to save match", "match_number", req.MatchNumber)
+
+	if err := s.matchService.SaveMatch(ctx, ProtoToDomainMatch(req)); err != nil {
+		playerLog.ErrorContext(ctx, "❌ Failed to save match", "err", err)
+		return nil, toStatus(err, "failed to save match", matchResourceType, "")
+	}
+
+	return &pb.SaveMatchResponse{Message: "Match saved successfully"}, nil
 }
 
-func convertDomainMatchToProto(match domain.Match) *pb.Match {
-	pbMatch := &pb.Match{
-		Id:          int32(match.ID),
-		MatchNumber: int32(match.MatchNumber),
-		StartTime:   timestamppb.New(match.StartTime),
-		Winner:      match.Winner,
+func (s *PlayerServer) GetPlayerStats(ctx context.Context, req *pb.GetPlayerStatsRequest) (*pb.PlayerSummary, error) {
+	playerLog.DebugContext(ctx, "📊 Request for player stats", "player_id", req.PlayerId)
+
+	summary, err := s.matchService.GetPlayerStats(ctx, req.PlayerId)
+	if err != nil {
+		playerLog.WarnContext(ctx, "❌ Player stats not available", "player_id", req.PlayerId, "err", err)
+		return nil, toStatus(err, "player stats not available", playerResourceType, req.PlayerId)
 	}
 
-	if !match.EndTime.IsZero() {
-		pbMatch.EndTime = timestamppb.New(match.EndTime)
+	return DomainPlayerSummaryToProto(summary), nil
+}
+
+func (s *PlayerServer) GetMatchStats(ctx context.Context, req *pb.GetMatchByIDRequest) (*pb.MatchStats, error) {
+	id := int(req.Id)
+	playerLog.DebugContext(ctx, "📊 Request for match stats", "id", id)
+
+	stats, err := s.matchService.GetMatchStats(ctx, id)
+	if err != nil {
+		playerLog.WarnContext(ctx, "❌ Match stats not available", "id", id, "err", err)
+		return nil, matchStatus(err, "match stats not available", id)
 	}
 
-	pbTurns := make([]*pb.Turn, len(match.Turns))
-	for i, turn := range match.Turns {
-		pbTurns[i] = &pb.Turn{
-			Id:          int32(turn.ID),
-			TurnNumber:  int32(turn.TurnNumber),
-			Time:        timestamppb.New(turn.Time),
-			Player:      turn.Player,
-			BallPower:   int32(turn.BallPower),
-			RoutineId:   turn.RoutineID,
-			MatchNumber: int32(turn.MatchNumber),
-		}
+	return DomainMatchStatsToProto(stats), nil
+}
+
+func (s *PlayerServer) TestDB(ctx context.Context, req *pb.TestDBRequest) (*pb.TestDBResponse, error) {
+	playerLog.DebugContext(ctx, "🧪 Testing database connections...")
+
+	status, err := s.matchService.TestConnection(ctx)
+	if err != nil {
+		playerLog.ErrorContext(ctx, "❌ Database test failed", "err", err)
+		return nil, toStatus(err, "database test failed", "", "")
 	}
-	pbMatch.Turns = pbTurns
 
-	return pbMatch
+	playerLog.InfoContext(ctx, "✅ Database reachable", "latency", status.Latency, "schema_version", status.SchemaVersion)
+	return &pb.TestDBResponse{
+		Message:       "Database test completed successfully",
+		LatencyMs:     float64(status.Latency.Microseconds()) / 1000,
+		SchemaVersion: int32(status.SchemaVersion),
+	}, nil
+}
+
+func (s *PlayerServer) IsGameActive(ctx context.Context, _ *emptypb.Empty) (*pb.IsGameActiveResponse, error) {
+	return &pb.IsGameActiveResponse{
+		Active: s.inState(matchActive),
+	}, nil
 }
 
 type TableServer struct {
 	pb.UnimplementedTableServiceServer
 	PlayerClient pb.PlayerServiceClient
+	rules        *liveRules
 }
 
 func NewTableServer(playerConn *grpc.ClientConn) *TableServer {
-	return &TableServer{
-		PlayerClient: pb.NewPlayerServiceClient(playerConn),
+	s := &TableServer{rules: newLiveRules()}
+	if playerConn != nil {
+		s.PlayerClient = pb.NewPlayerServiceClient(playerConn)
 	}
+	return s
 }
 
 func (s *TableServer) StartGame(ctx context.Context, req *pb.StartGameRequest) (*pb.StartGameResponse, error) {
-	log.Println("🎮 Table received start game request")
-	
-	initialPower := 70 + int(time.Now().UnixNano()%30)
-	log.Printf("🎾 Starting game with initial power: %d", initialPower)
-	
+	tableLog.DebugContext(ctx, "🎮 Table received start game request")
+
+	servePower := s.rules.load().ServePower
+	if req.GameRules != nil {
+		servePower = ProtoToDomainGameRules(req.GameRules).ServePower
+	}
+	initialPower := servePower.Pick(time.Now().UnixNano())
+	tableLog.InfoContext(ctx, "🎾 Serving", "power", initialPower)
+
+	ctx = context.WithoutCancel(ctx)
 	go func() {
-		log.Printf("📤 Table sending to Player A with initial power: %d", initialPower)
-		
-		_, err := s.PlayerClient.PlayerAPing(context.Background(), &pb.PingRequest{
+		tableLog.DebugContext(ctx, "📤 Table sending serve to Player A", "power", initialPower)
+
+		_, err := s.PlayerClient.PlayerAPing(ctx, &pb.PingRequest{
 			BallPower: int32(initialPower),
 		})
 		if err != nil {
-			log.Printf("❌ Failed to ping Player A: %v", err)
+			tableLog.ErrorContext(ctx, "❌ Failed to ping Player A", "err", err)
 			return
 		}
-		log.Printf("✅ Successfully sent initial ping to Player A")
+		tableLog.DebugContext(ctx, "✅ Successfully sent initial ping to Player A")
 	}()
-	
+
 	return &pb.StartGameResponse{Message: "Game started"}, nil
 }
 
 func (s *TableServer) ReceiveBall(ctx context.Context, req *pb.ReceiveBallRequest) (*pb.ReceiveBallResponse, error) {
-	log.Println("📥 Table received ball")
-
 	ballPower := int(req.BallPower)
 	fromPlayer := req.FromPlayer
 
-	log.Printf("🎾 Table received ball from Player %s with power %d", fromPlayer, ballPower)
+	tableLog.DebugContext(ctx, "📥 Table received ball", "from", fromPlayer, "power", ballPower)
 
-	activeRes, err := s.PlayerClient.IsGameActive(context.Background(), &emptypb.Empty{})
+	activeRes, err := s.PlayerClient.IsGameActive(ctx, &emptypb.Empty{})
 	if err != nil {
-		log.Printf("❌ Failed to check game status: %v", err)
+		tableLog.ErrorContext(ctx, "❌ Failed to check game status", "err", err)
 	} else if !activeRes.Active {
-		log.Printf("🏁 Match already ended (checked via PlayerServer). Not forwarding ball.")
+		tableLog.DebugContext(ctx, "🏁 Match already ended (checked via PlayerServer). Not forwarding ball.")
 		return &pb.ReceiveBallResponse{}, nil
-	}	
+	}
 
+	ctx = context.WithoutCancel(ctx)
 	go func() {
 		if fromPlayer == "A" {
-			log.Printf("📤 Table forwarding ball to Player B")
-			_, err := s.PlayerClient.PlayerBPing(context.Background(), &pb.PingRequest{
+			tableLog.DebugContext(ctx, "📤 Table forwarding ball", "to", "B")
+			_, err := s.PlayerClient.PlayerBPing(ctx, &pb.PingRequest{
 				BallPower: int32(ballPower),
 			})
 			if err != nil {
-				log.Printf("❌ Failed to forward ball to Player B: %v", err)
+				tableLog.ErrorContext(ctx, "❌ Failed to forward ball", "to", "B", "err", err)
 				return
 			}
 		} else {
-			log.Printf("📤 Table forwarding ball to Player A")
-			_, err := s.PlayerClient.PlayerAPing(context.Background(), &pb.PingRequest{
+			tableLog.DebugContext(ctx, "📤 Table forwarding ball", "to", "A")
+			_, err := s.PlayerClient.PlayerAPing(ctx, &pb.PingRequest{
 				BallPower: int32(ballPower),
 			})
 			if err != nil {
-				log.Printf("❌ Failed to forward ball to Player A: %v", err)
+				tableLog.ErrorContext(ctx, "❌ Failed to forward ball", "to", "A", "err", err)
 				return
 			}
 		}
-		log.Printf("✅ Successfully forwarded ball")
+		tableLog.DebugContext(ctx, "✅ Successfully forwarded ball")
 	}()
 
 	return &pb.ReceiveBallResponse{}, nil
 }
 
-func StartGRPCServer(server interface{}, port string) {
-	lis, err := net.Listen("tcp", ":"+port)
-	if err != nil {
-		log.Fatalf("❌ Failed to listen on port %s: %v", port, err)
-	}
-	
-	grpcServer := grpc.NewServer()
-	
+// NewGRPCServer registers a PlayerServer or TableServer, together with its
+// health checks, on a new gRPC server that validates every request. opts are
+// applied first, so interceptors given there run before validation.
+func NewGRPCServer(server interface{}, opts ...grpc.ServerOption) *grpc.Server {
+	grpcServer := grpc.NewServer(append(opts,
+		grpc.ChainUnaryInterceptor(ValidationUnaryInterceptor),
+		grpc.ChainStreamInterceptor(ValidationStreamInterceptor),
+	)...)
+	healthServer := health.NewServer()
+	healthpb.RegisterHealthServer(grpcServer, healthServer)
+
 	switch s := server.(type) {
 	case *PlayerServer:
 		pb.RegisterPlayerServiceServer(grpcServer, s)
-		log.Printf("🏓 Player gRPC server starting on port %s", port)
+		go watchHealth(healthServer, pb.PlayerService_ServiceDesc.ServiceName, s.healthChecks(), playerLog)
 	case *TableServer:
 		pb.RegisterTableServiceServer(grpcServer, s)
-		log.Printf("🏓 Table gRPC server starting on port %s", port)
-	}
-	
-	if err := grpcServer.Serve(lis); err != nil {
-		log.Fatalf("❌ Failed to serve: %v", err)
+		go watchHealth(healthServer, pb.TableService_ServiceDesc.ServiceName, s.healthChecks(), tableLog)
 	}
-}
\ No newline at end of file
+
+	return grpcServer
+}
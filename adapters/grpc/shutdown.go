@@ -0,0 +1,95 @@
+package grpc
+
+import (
+	"context"
+	"time"
+
+	"pingpong/domain"
+)
+
+const (
+	drainPollInterval = 100 * time.Millisecond
+	// drainSaveGrace bounds how long Drain waits, once ctx is done, for a
+	// match result that is being saved.
+	drainSaveGrace = 5 * time.Second
+)
+
+// Drain stops new matches from starting and gives the match in progress until
+// ctx is done to finish on its own. A match still running then, or one that
+// is paused and so cannot finish, is aborted and its result saved. Drain
+// returns once no result is being saved, or after drainSaveGrace.
+func (s *PlayerServer) Drain(ctx context.Context) {
+	s.draining.Store(true)
+	if s.inState(matchIdle) {
+		return
+	}
+
+	playerLog.InfoContext(s.matchContext(), "⏳ Waiting for the match to finish...")
+	ticker := time.NewTicker(drainPollInterval)
+	defer ticker.Stop()
+	for !s.inState(matchIdle) && !s.pause.paused() {
+		select {
+		case <-ctx.Done():
+			s.abortMatch(domain.ReasonShutdown)
+			s.waitSaved()
+			return
+		case <-ticker.C:
+		}
+	}
+	s.abortMatch(domain.ReasonShutdown)
+	s.waitSaved()
+}
+
+// waitSaved waits up to drainSaveGrace for the result of a match that
+// another goroutine ended, such as the last hit, to be saved.
+func (s *PlayerServer) waitSaved() {
+	deadline := time.Now().Add(drainSaveGrace)
+	for !s.inState(matchIdle) {
+		if time.Now().After(deadline) {
+			playerLog.WarnContext(s.matchContext(), "⚠️ Gave up waiting for the match result to be saved", "grace", drainSaveGrace)
+			return
+		}
+		time.Sleep(drainPollInterval / 10)
+	}
+}
+
+// abortMatch ends the match in progress without a winner, unless it has
+// already ended.
+func (s *PlayerServer) abortMatch(reason string) {
+	if match, ok := s.endMatch(domain.WinnerAborted); ok {
+		s.saveAborted(match, reason)
+	}
+}
+
+// watchMatch aborts the match numbered number if it is still in play after
+// MatchTimeout, so a lost ball cannot keep later matches from starting.
+func (s *PlayerServer) watchMatch(number int) {
+	if s.MatchTimeout <= 0 {
+		return
+	}
+	time.AfterFunc(s.MatchTimeout, func() {
+		s.matchesMutex.Lock()
+		var match domain.Match
+		ok := false
+		if s.currentMatch.MatchNumber == number {
+			match, ok = s.endMatchLocked(domain.WinnerAborted)
+		}
+		s.matchesMutex.Unlock()
+
+		if ok {
+			playerLog.WarnContext(s.matchContext(), "⌛ Match timed out", "timeout", s.MatchTimeout)
+			s.saveAborted(match, domain.ReasonTimeout)
+		}
+	})
+}
+
+func (s *PlayerServer) saveAborted(match domain.Match, reason string) {
+	ctx := s.matchContext()
+	playerLog.InfoContext(ctx, "⏹️ Aborting match", "reason", reason)
+
+	// Let anything holding the ball see that the match is over.
+	s.shots.close()
+	s.pause.set(false)
+
+	s.saveMatchResult(ctx, match, reason)
+}
@@ -0,0 +1,167 @@
+package grpc
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+
+	"pingpong/adapters/turnlog"
+	"pingpong/domain"
+	"pingpong/ports"
+	pb "pingpong/proto"
+)
+
+// matchStore records events and finished matches from many goroutines.
+type matchStore struct {
+	ports.MatchService
+	mu       sync.Mutex
+	events   []domain.Event
+	finished []domain.Event
+	// saving, when set, holds FinishMatch until it is closed.
+	saving chan struct{}
+}
+
+func (s *matchStore) RecordEvent(ctx context.Context, event domain.Event) (domain.Event, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if event.Type == domain.EventMatchStarted {
+		event.MatchID = event.Payload.MatchNumber
+	}
+	s.events = append(s.events, event)
+	return event, nil
+}
+
+func (s *matchStore) FinishMatch(ctx context.Context, event domain.Event, turns []domain.Turn) (domain.Event, error) {
+	if s.saving != nil {
+		<-s.saving
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.finished = append(s.finished, event)
+	return event, nil
+}
+
+// quietTable drops every ball it is sent.
+type quietTable struct {
+	pb.TableServiceClient
+}
+
+func (quietTable) StartGame(ctx context.Context, req *pb.StartGameRequest, opts ...grpc.CallOption) (*pb.StartGameResponse, error) {
+	return &pb.StartGameResponse{}, nil
+}
+
+func (quietTable) ReceiveBall(ctx context.Context, req *pb.ReceiveBallRequest, opts ...grpc.CallOption) (*pb.ReceiveBallResponse, error) {
+	return &pb.ReceiveBallResponse{}, nil
+}
+
+func newTestServer(svc ports.MatchService) *PlayerServer {
+	s := NewPlayerServer(svc, turnlog.NopSink{}, nil)
+	s.TableClient = quietTable{}
+	return s
+}
+
+// waitIdle fails the test unless s is idle within a second.
+func waitIdle(t *testing.T, s *PlayerServer) {
+	t.Helper()
+	deadline := time.Now().Add(time.Second)
+	for !s.inState(matchIdle) {
+		if time.Now().After(deadline) {
+			t.Fatal("match did not end")
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+// Run with -race: the timeout aborts matches while both players are hitting.
+func TestMatchTimeoutRacesTurns(t *testing.T) {
+	for name, window := range map[string]time.Duration{"bot": 0, "human": time.Millisecond} {
+		t.Run(name, func(t *testing.T) {
+			svc := &matchStore{}
+			s := newTestServer(svc)
+			s.MatchTimeout = 5 * time.Millisecond
+			s.HumanShotWindow = window
+			ctx := context.Background()
+
+			const matches = 20
+			for range matches {
+				if err := s.startMatch(ctx); err != nil {
+					t.Fatalf("startMatch: %v", err)
+				}
+
+				var wg sync.WaitGroup
+				for _, ping := range []func(context.Context, *pb.PingRequest) (*pb.PingResponse, error){s.PlayerAPing, s.PlayerBPing} {
+					wg.Add(1)
+					go func() {
+						defer wg.Done()
+						for !s.inState(matchIdle) {
+							ping(ctx, &pb.PingRequest{BallPower: 1})
+							s.PauseMatch(ctx, &pb.PauseMatchRequest{Paused: false})
+						}
+					}()
+				}
+				wg.Wait()
+				waitIdle(t, s)
+			}
+
+			svc.mu.Lock()
+			defer svc.mu.Unlock()
+			if len(svc.finished) != matches {
+				t.Fatalf("finished %d matches, want %d", len(svc.finished), matches)
+			}
+			for _, event := range svc.finished {
+				if event.MatchID == 0 || event.Payload.RoutineID == "" {
+					t.Errorf("match finished with match ID %d and routine ID %q", event.MatchID, event.Payload.RoutineID)
+				}
+			}
+		})
+	}
+}
+
+func TestDrainWaitsForResultBeingSaved(t *testing.T) {
+	svc := &matchStore{saving: make(chan struct{})}
+	s := newTestServer(svc)
+	ctx := context.Background()
+	if _, err := s.initMatch(ctx); err != nil {
+		t.Fatalf("initMatch: %v", err)
+	}
+
+	// The match is won and its result is being saved when Drain runs out of
+	// time.
+	match, ok := s.endMatch("A")
+	if !ok {
+		t.Fatal("endMatch of the match in progress failed")
+	}
+	go s.saveMatchResult(ctx, match, domain.ReasonWeakReturn)
+
+	expired, cancel := context.WithCancel(ctx)
+	cancel()
+	drained := make(chan struct{})
+	go func() {
+		s.Drain(expired)
+		close(drained)
+	}()
+
+	select {
+	case <-drained:
+		t.Fatal("Drain returned while the match result was being saved")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	close(svc.saving)
+	select {
+	case <-drained:
+	case <-time.After(time.Second):
+		t.Fatal("Drain did not return once the match result was saved")
+	}
+	if !s.inState(matchIdle) {
+		t.Error("server is not idle after Drain")
+	}
+	svc.mu.Lock()
+	defer svc.mu.Unlock()
+	if len(svc.finished) != 1 || svc.finished[0].Payload.Winner != "A" {
+		t.Errorf("finished %+v, want the match won by A", svc.finished)
+	}
+}
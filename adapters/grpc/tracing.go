@@ -0,0 +1,77 @@
+package grpc
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+
+	"pingpong/domain"
+	"pingpong/logging"
+)
+
+var tracer = otel.Tracer("pingpong/adapters/grpc")
+
+// startMatchSpan begins the span every hit of the current match descends
+// from. It is a child of ctx's span, if any, such as the StartNewMatch call.
+func (s *PlayerServer) startMatchSpan(ctx context.Context, resumed bool) context.Context {
+	ctx, s.matchSpan = tracer.Start(ctx, "match", trace.WithAttributes(
+		attribute.Int("match.number", s.currentMatch.MatchNumber),
+		attribute.Int("match.id", s.currentMatch.ID),
+		attribute.String("match.routine_id", s.routineID),
+		attribute.Bool("match.resumed", resumed),
+	))
+	return ctx
+}
+
+// matchContext carries the current match's span and log attributes, for
+// work that is not triggered by a ball, such as aborting the match.
+func (s *PlayerServer) matchContext() context.Context {
+	s.matchesMutex.Lock()
+	defer s.matchesMutex.Unlock()
+
+	ctx := context.Background()
+	if s.matchSpan != nil {
+		ctx = trace.ContextWithSpan(ctx, s.matchSpan)
+	}
+	return s.withMatchLocked(ctx)
+}
+
+func (s *PlayerServer) endMatchSpan(match domain.Match, reason string) {
+	s.matchesMutex.Lock()
+	span := s.matchSpan
+	s.matchSpan = nil
+	s.matchesMutex.Unlock()
+	if span == nil {
+		return
+	}
+
+	span.SetAttributes(
+		attribute.Int("match.id", match.ID),
+		attribute.String("match.winner", match.Winner),
+		attribute.String("match.reason", reason),
+		attribute.Int("match.turns", len(match.Turns)),
+	)
+	span.End()
+}
+
+// startHit begins the span of one player's turn, from receiving the ball to
+// sending it back, and tags ctx so the turn's log lines carry it.
+func (s *PlayerServer) startHit(ctx context.Context, player string, turn int, receivedPower int) (context.Context, trace.Span) {
+	match, _ := s.current()
+	ctx = logging.WithTurn(s.withMatch(ctx), turn, player)
+	return tracer.Start(ctx, "hit", trace.WithAttributes(
+		attribute.Int("match.number", match.MatchNumber),
+		attribute.Int("turn", turn),
+		attribute.String("player", player),
+		attribute.Int("ball.power", receivedPower),
+	))
+}
+
+// spanError marks span as failed with err.
+func spanError(span trace.Span, err error) {
+	span.RecordError(err)
+	span.SetStatus(codes.Error, err.Error())
+}
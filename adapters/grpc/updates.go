@@ -0,0 +1,158 @@
+package grpc
+
+import (
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"pingpong/domain"
+	pb "pingpong/proto"
+)
+
+const updateBufferSize = 64
+
+// updateBroadcaster fans live match updates out to every WatchMatch stream.
+// Slow subscribers miss updates rather than holding up the rally.
+type updateBroadcaster struct {
+	mu          sync.Mutex
+	subscribers map[chan *pb.MatchUpdate]struct{}
+}
+
+func (b *updateBroadcaster) subscribe() (chan *pb.MatchUpdate, func()) {
+	ch := make(chan *pb.MatchUpdate, updateBufferSize)
+
+	b.mu.Lock()
+	if b.subscribers == nil {
+		b.subscribers = map[chan *pb.MatchUpdate]struct{}{}
+	}
+	b.subscribers[ch] = struct{}{}
+	b.mu.Unlock()
+
+	return ch, func() {
+		b.mu.Lock()
+		delete(b.subscribers, ch)
+		b.mu.Unlock()
+	}
+}
+
+func (b *updateBroadcaster) publish(update *pb.MatchUpdate) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	for ch := range b.subscribers {
+		select {
+		case ch <- update:
+		default:
+			playerLog.Warn("⚠️ Dropping match update for slow watcher")
+		}
+	}
+}
+
+// SubscribeUpdates lets other front-ends in the process follow live matches.
+func (s *PlayerServer) SubscribeUpdates() (<-chan *pb.MatchUpdate, func()) {
+	return s.updates.subscribe()
+}
+
+func matchStartedUpdate(match domain.Match) *pb.MatchUpdate {
+	return &pb.MatchUpdate{
+		Kind:        pb.MatchUpdate_MATCH_STARTED,
+		MatchId:     int32(match.ID),
+		MatchNumber: int32(match.MatchNumber),
+		Time:        timestamppb.New(match.StartTime),
+	}
+}
+
+func turnUpdate(match domain.Match, turn domain.Turn) *pb.MatchUpdate {
+	return &pb.MatchUpdate{
+		Kind:        pb.MatchUpdate_TURN,
+		MatchId:     int32(match.ID),
+		MatchNumber: int32(match.MatchNumber),
+		Time:        timestamppb.New(turn.Time),
+		Turn:        DomainTurnToProto(turn),
+	}
+}
+
+func matchFinishedUpdate(match domain.Match) *pb.MatchUpdate {
+	return &pb.MatchUpdate{
+		Kind:        pb.MatchUpdate_MATCH_FINISHED,
+		MatchId:     int32(match.ID),
+		MatchNumber: int32(match.MatchNumber),
+		Time:        timestamppb.New(match.EndTime),
+		Winner:      match.Winner,
+	}
+}
+
+func (s *PlayerServer) WatchMatch(req *pb.WatchMatchRequest, stream grpc.ServerStreamingServer[pb.MatchUpdate]) error {
+	ctx := stream.Context()
+	playerLog.InfoContext(ctx, "👀 Watcher connected", "match_id", req.MatchId)
+
+	updates, unsubscribe := s.updates.subscribe()
+	defer unsubscribe()
+	// The headers tell the client it is subscribed, so it can start a match
+	// without missing the match's first update.
+	if err := stream.SendHeader(metadata.MD{}); err != nil {
+		return err
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			playerLog.InfoContext(ctx, "👋 Watcher disconnected")
+			return nil
+		case update := <-updates:
+			if req.MatchId != 0 && update.MatchId != req.MatchId {
+				continue
+			}
+			if err := stream.Send(update); err != nil {
+				return err
+			}
+			if req.MatchId != 0 && update.Kind == pb.MatchUpdate_MATCH_FINISHED {
+				return nil
+			}
+		}
+	}
+}
+
+func (s *PlayerServer) ReplayMatch(req *pb.ReplayMatchRequest, stream grpc.ServerStreamingServer[pb.MatchUpdate]) error {
+	id := int(req.MatchId)
+	playerLog.InfoContext(stream.Context(), "⏪ Replaying match", "id", id, "speed", req.Speed)
+
+	match, err := s.matchService.GetMatchByID(stream.Context(), id)
+	if err != nil {
+		playerLog.WarnContext(stream.Context(), "❌ Match not found", "id", id, "err", err)
+		return matchStatus(err, "match not found", id)
+	}
+
+	updates := []*pb.MatchUpdate{matchStartedUpdate(match)}
+	for _, turn := range match.Turns {
+		updates = append(updates, turnUpdate(match, turn))
+	}
+	if !match.EndTime.IsZero() {
+		updates = append(updates, matchFinishedUpdate(match))
+	}
+
+	var previous time.Time
+	for _, update := range updates {
+		at := update.Time.AsTime()
+		if req.Speed > 0 && !previous.IsZero() && at.After(previous) {
+			delay := time.Duration(float64(at.Sub(previous)) / req.Speed)
+			select {
+			case <-time.After(delay):
+			case <-stream.Context().Done():
+				return stream.Context().Err()
+			}
+		}
+		previous = at
+
+		update.Replay = true
+		if err := stream.Send(update); err != nil {
+			return err
+		}
+	}
+
+	playerLog.InfoContext(stream.Context(), "✅ Replay finished", "id", id)
+	return nil
+}
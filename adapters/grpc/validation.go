@@ -0,0 +1,64 @@
+package grpc
+
+import (
+	"context"
+	"log/slog"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	protobuf "google.golang.org/protobuf/proto"
+
+	pb "pingpong/proto"
+)
+
+// ValidationError checks msg against its declared field rules and returns an
+// InvalidArgument status carrying a BadRequest detail, or nil when it is valid.
+func ValidationError(msg any) error {
+	m, ok := msg.(protobuf.Message)
+	if !ok {
+		return nil
+	}
+	violations := pb.Validate(m)
+	if len(violations) == 0 {
+		return nil
+	}
+
+	st := status.New(codes.InvalidArgument, "invalid "+string(m.ProtoReflect().Descriptor().Name())+": "+violations[0].Description)
+	withDetails, err := st.WithDetails(
+		errorInfo(reasonInvalid, "", ""),
+		&errdetails.BadRequest{FieldViolations: violations},
+	)
+	if err != nil {
+		slog.Warn("⚠️ Failed to attach error details", "err", err)
+		return st.Err()
+	}
+	return withDetails.Err()
+}
+
+// ValidationUnaryInterceptor rejects requests that break their field rules
+// before they reach the handler.
+func ValidationUnaryInterceptor(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	if err := ValidationError(req); err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+// ValidationStreamInterceptor does the same for every message a streaming
+// handler receives.
+func ValidationStreamInterceptor(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	return handler(srv, &validatingStream{ServerStream: ss})
+}
+
+type validatingStream struct {
+	grpc.ServerStream
+}
+
+func (s *validatingStream) RecvMsg(m any) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	return ValidationError(m)
+}
@@ -0,0 +1,89 @@
+package grpc
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	protobuf "google.golang.org/protobuf/proto"
+
+	pb "pingpong/proto"
+)
+
+// fieldViolations returns the fields of the BadRequest detail of err, which
+// must be an InvalidArgument status.
+func fieldViolations(t *testing.T, err error) []string {
+	t.Helper()
+	st, ok := status.FromError(err)
+	if !ok || st.Code() != codes.InvalidArgument {
+		t.Fatalf("error = %v, want an InvalidArgument status", err)
+	}
+
+	var fields []string
+	var info *errdetails.ErrorInfo
+	for _, detail := range st.Details() {
+		switch d := detail.(type) {
+		case *errdetails.BadRequest:
+			for _, v := range d.FieldViolations {
+				fields = append(fields, v.Field)
+			}
+		case *errdetails.ErrorInfo:
+			info = d
+		}
+	}
+	if info == nil || info.Reason != reasonInvalid {
+		t.Errorf("ErrorInfo = %v, want reason %s", info, reasonInvalid)
+	}
+	return fields
+}
+
+func TestValidationUnaryInterceptor(t *testing.T) {
+	called := false
+	handler := func(ctx context.Context, req any) (any, error) {
+		called = true
+		return &pb.ReceiveBallResponse{}, nil
+	}
+	info := &grpc.UnaryServerInfo{FullMethod: "/pingpong.TableService/ReceiveBall"}
+
+	_, err := ValidationUnaryInterceptor(context.Background(), &pb.ReceiveBallRequest{BallPower: 120, FromPlayer: "C"}, info, handler)
+	if called {
+		t.Error("handler was called with an invalid request")
+	}
+	if fields := fieldViolations(t, err); !slices.Equal(fields, []string{"ball_power", "from_player"}) {
+		t.Errorf("violations on %v, want [ball_power from_player]", fields)
+	}
+
+	if _, err := ValidationUnaryInterceptor(context.Background(), &pb.ReceiveBallRequest{BallPower: 80, FromPlayer: "A"}, info, handler); err != nil {
+		t.Errorf("valid request: %v", err)
+	}
+	if !called {
+		t.Error("handler was not called with a valid request")
+	}
+}
+
+// requestStream is a server stream that receives one request.
+type requestStream struct {
+	grpc.ServerStream
+	req protobuf.Message
+}
+
+func (s requestStream) RecvMsg(m any) error {
+	protobuf.Merge(m.(protobuf.Message), s.req)
+	return nil
+}
+
+func TestValidationStreamInterceptor(t *testing.T) {
+	stream := requestStream{req: &pb.ReplayMatchRequest{MatchId: 0, Speed: 2}}
+	handler := func(srv any, ss grpc.ServerStream) error {
+		return ss.RecvMsg(&pb.ReplayMatchRequest{})
+	}
+
+	err := ValidationStreamInterceptor(nil, stream, &grpc.StreamServerInfo{}, handler)
+	if fields := fieldViolations(t, err); !slices.Equal(fields, []string{"match_id"}) {
+		t.Errorf("violations on %v, want [match_id]", fields)
+	}
+}
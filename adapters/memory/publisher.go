@@ -0,0 +1,88 @@
+package memory
+
+import (
+	"context"
+	"slices"
+	"sync"
+
+	"pingpong/domain"
+)
+
+// Publisher keeps published events in memory and hands them to subscribers.
+// It is meant for tests and for running without a message broker.
+type Publisher struct {
+	mu          sync.Mutex
+	events      []domain.Event
+	subscribers []chan domain.Event
+	closed      bool
+	// sending is held for reading while events are handed to subscribers,
+	// so Close does not close a channel under a send.
+	sending sync.RWMutex
+}
+
+func NewPublisher() *Publisher {
+	return &Publisher{}
+}
+
+// Publish records event and sends it to every subscriber, waiting for each to
+// have room. A slow subscriber delays only this call, not other publishers or
+// subscribers.
+func (p *Publisher) Publish(ctx context.Context, event domain.Event) error {
+	p.sending.RLock()
+	defer p.sending.RUnlock()
+
+	p.mu.Lock()
+	p.events = append(p.events, event)
+	subscribers := slices.Clone(p.subscribers)
+	p.mu.Unlock()
+
+	for _, ch := range subscribers {
+		select {
+		case ch <- event:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	return nil
+}
+
+// Subscribe returns a channel receiving every event published from now on.
+// Publish blocks until each subscriber has room, so size the buffer for the
+// expected burst.
+func (p *Publisher) Subscribe(buffer int) <-chan domain.Event {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	ch := make(chan domain.Event, buffer)
+	if p.closed {
+		close(ch)
+		return ch
+	}
+	p.subscribers = append(p.subscribers, ch)
+	return ch
+}
+
+func (p *Publisher) Events() []domain.Event {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return append([]domain.Event(nil), p.events...)
+}
+
+// Close closes every subscriber's channel once the events being published
+// have been handed over.
+func (p *Publisher) Close() error {
+	p.sending.Lock()
+	defer p.sending.Unlock()
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if !p.closed {
+		for _, ch := range p.subscribers {
+			close(ch)
+		}
+		p.subscribers = nil
+		p.closed = true
+	}
+	return nil
+}
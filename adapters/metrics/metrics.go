@@ -0,0 +1,83 @@
+// Package metrics exports Prometheus metrics for matches, gRPC calls and the
+// database connection pool.
+package metrics
+
+import (
+	"database/sql"
+	"net/http"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+
+	"pingpong/domain"
+)
+
+// Registry holds every metric of the process. The Player and Table services
+// share it when they run in the same binary.
+var Registry = prometheus.NewRegistry()
+
+var (
+	matchesStarted = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "pingpong_matches_started_total",
+		Help: "Matches started.",
+	})
+	matchesFinished = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "pingpong_matches_finished_total",
+		Help: "Matches that ended with a result, by winner.",
+	}, []string{"winner"})
+	matchesAborted = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "pingpong_matches_aborted_total",
+		Help: "Matches aborted before a winner was decided, by reason.",
+	}, []string{"reason"})
+	turnsPerMatch = prometheus.NewHistogram(prometheus.HistogramOpts{
+		Name:    "pingpong_turns_per_match",
+		Help:    "Turns played in each finished or aborted match.",
+		Buckets: prometheus.LinearBuckets(2, 2, 15),
+	})
+	ballPower = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "pingpong_ball_power",
+		Help:    "Power of the balls each player received.",
+		Buckets: prometheus.LinearBuckets(10, 10, 10),
+	}, []string{"player"})
+)
+
+func init() {
+	Registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		matchesStarted, matchesFinished, matchesAborted, turnsPerMatch, ballPower,
+		rpcRequests, rpcDuration,
+	)
+}
+
+// Handler serves Registry in the Prometheus text format.
+func Handler() http.Handler {
+	return promhttp.HandlerFor(Registry, promhttp.HandlerOpts{Registry: Registry})
+}
+
+// RegisterDB exports the connection pool stats of db, as reported by
+// sql.DB.Stats, labelled with name.
+func RegisterDB(db *sql.DB, name string) error {
+	return Registry.Register(collectors.NewDBStatsCollector(db, name))
+}
+
+// MatchMetrics records match metrics for a PlayerServer.
+type MatchMetrics struct{}
+
+func (MatchMetrics) MatchStarted() {
+	matchesStarted.Inc()
+}
+
+func (MatchMetrics) BallHit(player string, power int) {
+	ballPower.WithLabelValues(player).Observe(float64(power))
+}
+
+func (MatchMetrics) MatchFinished(match domain.Match, reason string) {
+	if match.Winner == domain.WinnerAborted {
+		matchesAborted.WithLabelValues(reason).Inc()
+	} else {
+		matchesFinished.WithLabelValues(match.Winner).Inc()
+	}
+	turnsPerMatch.Observe(float64(len(match.Turns)))
+}
@@ -0,0 +1,52 @@
+package mysql
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"net"
+
+	mysqldriver "github.com/go-sql-driver/mysql"
+
+	"pingpong/domain"
+)
+
+// MySQL server errors that mean the database cannot serve requests right now,
+// as opposed to a problem with the query itself.
+var unavailableErrorNumbers = map[uint16]bool{
+	1040: true, // ER_CON_COUNT_ERROR
+	1053: true, // ER_SERVER_SHUTDOWN
+	1205: true, // ER_LOCK_WAIT_TIMEOUT
+	1213: true, // ER_LOCK_DEADLOCK
+}
+
+// wrapError keeps the repository's "failed to ..." messages and classifies
+// the cause, so callers can match domain.ErrMatchNotFound and
+// domain.ErrStorageUnavailable with errors.Is.
+func wrapError(err error, message string) error {
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		return fmt.Errorf("%s: %w", message, domain.ErrMatchNotFound)
+	case isUnavailable(err):
+		return fmt.Errorf("%s: %w: %v", message, domain.ErrStorageUnavailable, err)
+	default:
+		return fmt.Errorf("%s: %v", message, err)
+	}
+}
+
+func isUnavailable(err error) bool {
+	if errors.Is(err, driver.ErrBadConn) || errors.Is(err, mysqldriver.ErrInvalidConn) ||
+		errors.Is(err, sql.ErrConnDone) || errors.Is(err, context.DeadlineExceeded) {
+		return true
+	}
+
+	var netErr net.Error
+	if errors.As(err, &netErr) {
+		return true
+	}
+
+	var mysqlErr *mysqldriver.MySQLError
+	return errors.As(err, &mysqlErr) && unavailableErrorNumbers[mysqlErr.Number]
+}
@@ -0,0 +1,75 @@
+package mysql
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+
+	"pingpong/domain"
+)
+
+func (r *MySQLRepository) AppendEvent(ctx context.Context, event domain.Event) (domain.Event, error) {
+	payload, err := json.Marshal(event.Payload)
+	if err != nil {
+		return domain.Event{}, wrapError(err, "failed to marshal event payload")
+	}
+
+	err = r.inTx(ctx, func(tx *sql.Tx) error {
+		err := tx.QueryRowContext(ctx,
+			"SELECT COALESCE(MAX(sequence), 0) + 1 FROM match_events WHERE match_id = ? FOR UPDATE",
+			event.MatchID).Scan(&event.Sequence)
+		if err != nil {
+			return wrapError(err, "failed to get next event sequence")
+		}
+
+		result, err := tx.ExecContext(ctx,
+			`INSERT INTO match_events (match_id, sequence, type, occurred_at, payload) 
+			 VALUES (?, ?, ?, ?, ?)`,
+			event.MatchID, event.Sequence, event.Type, event.Time, payload)
+		if err != nil {
+			return wrapError(err, "failed to append event")
+		}
+
+		id, err := result.LastInsertId()
+		if err != nil {
+			return wrapError(err, "failed to get last insert ID")
+		}
+		event.ID = int(id)
+		return nil
+	})
+	if err != nil {
+		return domain.Event{}, err
+	}
+
+	return event, nil
+}
+
+func (r *MySQLRepository) ListEvents(ctx context.Context, matchID int) ([]domain.Event, error) {
+	rows, err := r.conn.QueryContext(ctx,
+		`SELECT id, match_id, sequence, type, occurred_at, payload 
+		 FROM match_events WHERE match_id = ? ORDER BY sequence`, matchID)
+	if err != nil {
+		return nil, wrapError(err, "failed to fetch events")
+	}
+	defer rows.Close()
+
+	var events []domain.Event
+	for rows.Next() {
+		var event domain.Event
+		var payload []byte
+		err := rows.Scan(&event.ID, &event.MatchID, &event.Sequence, &event.Type, &event.Time, &payload)
+		if err != nil {
+			return nil, wrapError(err, "failed to scan event")
+		}
+		if err := json.Unmarshal(payload, &event.Payload); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal event %d: %v", event.ID, err)
+		}
+		events = append(events, event)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, wrapError(err, "failed to read events")
+	}
+
+	return events, nil
+}
@@ -5,196 +5,473 @@ import (
 	"database/sql"
 	"encoding/json"
 	"fmt"
-	"log"
+	"slices"
 	"time"
 
 	_ "github.com/go-sql-driver/mysql"
 	"pingpong/domain"
+	"pingpong/logging"
 )
 
+var logger = logging.For(logging.ComponentMySQL)
+
 type MySQLRepository struct {
-	db *sql.DB
+	db   *sql.DB
+	conn conn
+	// tx is set for the repository of a Transaction.
+	tx *sql.Tx
 }
 
 func NewMySQLRepository(connectionString string) (*MySQLRepository, error) {
 	db, err := sql.Open("mysql", connectionString)
 	if err != nil {
-		return nil, fmt.Errorf("failed to connect to MySQL: %v", err)
+		return nil, wrapError(err, "failed to connect to MySQL")
 	}
 
 	db.SetMaxOpenConns(10)
 	db.SetMaxIdleConns(5)
 	db.SetConnMaxLifetime(time.Minute * 3)
 
-
 	err = db.Ping()
 	if err != nil {
-		return nil, fmt.Errorf("failed to ping MySQL: %v", err)
+		return nil, wrapError(err, "failed to ping MySQL")
 	}
 
 	err = initSchema(db)
 	if err != nil {
-		return nil, fmt.Errorf("failed to initialize schema: %v", err)
+		return nil, wrapError(err, "failed to initialize schema")
 	}
 
-	return &MySQLRepository{db: db}, nil
+	return &MySQLRepository{db: db, conn: db}, nil
 }
 
-func initSchema(db *sql.DB) error {
-	_, err := db.Exec(`
-		CREATE TABLE IF NOT EXISTS matches (
-			id INT AUTO_INCREMENT PRIMARY KEY,
-			match_number INT NOT NULL,
-			start_time TIMESTAMP NOT NULL,
-			end_time TIMESTAMP NULL,
-			winner VARCHAR(10) NULL,
-			turns JSON NULL
-		)
-	`)
-	if err != nil {
-		return err
-	}
-
-	_, err = db.Exec(`
-		CREATE TABLE IF NOT EXISTS turns (
-			id INT AUTO_INCREMENT PRIMARY KEY,
-			turn_number INT NOT NULL,
-			time TIMESTAMP NOT NULL,
-			player VARCHAR(10) NOT NULL,
-			ball_power INT NOT NULL,
-			routine_id VARCHAR(50) NOT NULL,
-			match_number INT NOT NULL,
-			match_id INT NOT NULL,
-			FOREIGN KEY (match_id) REFERENCES matches(id)
-		)
-	`)
-	return err
+// DB is the connection pool behind the repository, for reporting its stats.
+func (r *MySQLRepository) DB() *sql.DB {
+	return r.db
 }
 
 func (r *MySQLRepository) SaveMatch(ctx context.Context, match domain.Match) error {
-	log.Printf("💾 Saving complete match #%d to MySQL...", match.MatchNumber)
+	logger.DebugContext(ctx, "💾 Saving complete match...", "match_number", match.MatchNumber)
 
-	tx, err := r.db.BeginTx(ctx, nil)
+	rules, err := rulesJSON(match.Rules)
 	if err != nil {
-		return fmt.Errorf("failed to begin transaction: %v", err)
+		return err
 	}
-	defer tx.Rollback()
 
-	var result sql.Result
-	query := `INSERT INTO matches (match_number, start_time, end_time, winner) 
-			  VALUES (?, ?, ?, ?)`
-	result, err = tx.ExecContext(ctx, query, match.MatchNumber, match.StartTime, 
-		match.EndTime, match.Winner)
+	var matchID int64
+	err = r.inTx(ctx, func(tx *sql.Tx) error {
+		var err error
+		matchID, err = saveMatch(ctx, tx, match, rules)
+		return err
+	})
+	if err != nil {
+		return err
+	}
 
+	logger.InfoContext(ctx, "✅ Complete match saved", "id", matchID)
+	return nil
+}
+
+func saveMatch(ctx context.Context, tx *sql.Tx, match domain.Match, rules any) (int64, error) {
+	query := `INSERT INTO matches (match_number, start_time, end_time, winner, rules)
+			  VALUES (?, ?, ?, ?, ?)`
+	result, err := tx.ExecContext(ctx, query, match.MatchNumber, match.StartTime,
+		match.EndTime, match.Winner, rules)
 	if err != nil {
-		return fmt.Errorf("failed to save match: %v", err)
+		return 0, wrapError(err, "failed to save match")
 	}
 
 	matchID, err := result.LastInsertId()
 	if err != nil {
-		return fmt.Errorf("failed to get last insert ID: %v", err)
+		return 0, wrapError(err, "failed to get last insert ID")
 	}
 
 	for _, turn := range match.Turns {
-		query := `INSERT INTO turns (turn_number, time, player, ball_power, routine_id, match_number, match_id) 
-				  VALUES (?, ?, ?, ?, ?, ?, ?)`
-		_, err = tx.ExecContext(ctx, query, turn.TurnNumber, turn.Time, turn.Player, 
-			turn.BallPower, turn.RoutineID, turn.MatchNumber, matchID)
-		if err != nil {
-			return fmt.Errorf("failed to save turn: %v", err)
+		if _, err := insertTurn(ctx, tx, int(matchID), turn); err != nil {
+			return 0, wrapError(err, "failed to save turn")
 		}
 	}
 
 	turnsJSON, err := json.Marshal(match.Turns)
 	if err != nil {
-		return fmt.Errorf("failed to marshal turns: %v", err)
+		return 0, wrapError(err, "failed to marshal turns")
 	}
 
 	_, err = tx.ExecContext(ctx, "UPDATE matches SET turns = ? WHERE id = ?", turnsJSON, matchID)
 	if err != nil {
-		return fmt.Errorf("failed to update turns JSON: %v", err)
+		return 0, wrapError(err, "failed to update turns JSON")
+	}
+	return matchID, nil
+}
+
+func (r *MySQLRepository) CreateMatch(ctx context.Context, match domain.Match) (int, error) {
+	logger.DebugContext(ctx, "💾 Creating match...", "match_number", match.MatchNumber)
+
+	rules, err := rulesJSON(match.Rules)
+	if err != nil {
+		return 0, err
+	}
+
+	result, err := r.conn.ExecContext(ctx,
+		"INSERT INTO matches (match_number, start_time, rules) VALUES (?, ?, ?)",
+		match.MatchNumber, match.StartTime, rules)
+	if err != nil {
+		return 0, wrapError(err, "failed to create match")
+	}
+
+	matchID, err := result.LastInsertId()
+	if err != nil {
+		return 0, wrapError(err, "failed to get last insert ID")
 	}
 
-	err = tx.Commit()
+	logger.InfoContext(ctx, "✅ Match created", "match_number", match.MatchNumber, "id", matchID)
+	return int(matchID), nil
+}
+
+func (r *MySQLRepository) AppendTurn(ctx context.Context, matchID int, turn domain.Turn) error {
+	_, err := insertTurn(ctx, r.conn, matchID, turn)
+	if err != nil {
+		return wrapError(err, "failed to append turn")
+	}
+
+	logger.DebugContext(ctx, "💾 Turn appended", "id", matchID, "turn_number", turn.TurnNumber)
+	return nil
+}
+
+func (r *MySQLRepository) FinishMatch(ctx context.Context, matchID int, endTime time.Time, winner string) error {
+	logger.DebugContext(ctx, "💾 Finishing match...", "id", matchID)
+
+	var turns []domain.Turn
+	err := r.inTx(ctx, func(tx *sql.Tx) error {
+		var err error
+		turns, err = queryTurns(ctx, tx, matchID)
+		if err != nil {
+			return err
+		}
+
+		turnsJSON, err := json.Marshal(turns)
+		if err != nil {
+			return wrapError(err, "failed to marshal turns")
+		}
+
+		result, err := tx.ExecContext(ctx,
+			"UPDATE matches SET end_time = ?, winner = ?, turns = ? WHERE id = ?",
+			endTime, winner, turnsJSON, matchID)
+		if err != nil {
+			return wrapError(err, "failed to finish match")
+		}
+
+		affected, err := result.RowsAffected()
+		if err != nil {
+			return wrapError(err, "failed to get affected rows")
+		}
+		if affected == 0 {
+			return fmt.Errorf("match with ID %d: %w", matchID, domain.ErrMatchNotFound)
+		}
+		return nil
+	})
 	if err != nil {
-		return fmt.Errorf("failed to commit transaction: %v", err)
+		return err
 	}
 
-	log.Printf("✅ Complete match saved to MySQL successfully with ID: %d", matchID)
+	logger.InfoContext(ctx, "✅ Match finished", "id", matchID, "turns", len(turns))
 	return nil
 }
 
+// rulesJSON encodes a match's game rules for the rules column, which is NULL
+// when they are unknown.
+func rulesJSON(rules *domain.GameRules) (any, error) {
+	if rules == nil {
+		return nil, nil
+	}
+	data, err := json.Marshal(rules)
+	if err != nil {
+		return nil, wrapError(err, "failed to marshal game rules")
+	}
+	return data, nil
+}
+
+type queryer interface {
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+}
+
+func insertTurn(ctx context.Context, e conn, matchID int, turn domain.Turn) (sql.Result, error) {
+	query := `INSERT INTO turns (turn_number, time, player, ball_power, routine_id, match_number, match_id)
+			  VALUES (?, ?, ?, ?, ?, ?, ?)`
+	return e.ExecContext(ctx, query, turn.TurnNumber, turn.Time, turn.Player,
+		turn.BallPower, turn.RoutineID, turn.MatchNumber, matchID)
+}
+
+func queryTurns(ctx context.Context, q queryer, matchID int) ([]domain.Turn, error) {
+	rows, err := q.QueryContext(ctx,
+		`SELECT id, turn_number, time, player, ball_power, routine_id, match_number
+         FROM turns WHERE match_id = ? ORDER BY turn_number`, matchID)
+	if err != nil {
+		return nil, wrapError(err, "failed to fetch turns")
+	}
+	defer rows.Close()
+
+	turns := []domain.Turn{}
+	for rows.Next() {
+		var turn domain.Turn
+		err := rows.Scan(&turn.ID, &turn.TurnNumber, &turn.Time, &turn.Player,
+			&turn.BallPower, &turn.RoutineID, &turn.MatchNumber)
+		if err != nil {
+			return nil, wrapError(err, "failed to scan turn")
+		}
+		turns = append(turns, turn)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, wrapError(err, "failed to read turns")
+	}
+
+	return turns, nil
+}
+
 func (r *MySQLRepository) GetMatchByID(ctx context.Context, id int) (domain.Match, error) {
-	log.Printf("📊 Fetching match with ID: %d from MySQL", id)
+	logger.DebugContext(ctx, "📊 Fetching match", "id", id)
 
 	var match domain.Match
-	var turnsJSON []byte
+	var endTime sql.NullTime
+	var winner sql.NullString
+	var turnsJSON, rulesData []byte
 
-	query := `SELECT id, match_number, start_time, end_time, winner, turns FROM matches WHERE id = ?`
-	err := r.db.QueryRowContext(ctx, query, id).Scan(
-		&match.ID, &match.MatchNumber, &match.StartTime, &match.EndTime, &match.Winner, &turnsJSON)
+	query := `SELECT id, match_number, start_time, end_time, winner, turns, rules FROM matches WHERE id = ?`
+	err := r.conn.QueryRowContext(ctx, query, id).Scan(
+		&match.ID, &match.MatchNumber, &match.StartTime, &endTime, &winner, &turnsJSON, &rulesData)
 	if err != nil {
-		return domain.Match{}, fmt.Errorf("failed to get match: %v", err)
+		return domain.Match{}, wrapError(err, "failed to get match")
+	}
+	match.EndTime = endTime.Time
+	match.Winner = winner.String
+
+	if rulesData != nil {
+		match.Rules = &domain.GameRules{}
+		if err := json.Unmarshal(rulesData, match.Rules); err != nil {
+			return domain.Match{}, wrapError(err, "failed to unmarshal game rules")
+		}
 	}
 
 	if turnsJSON != nil {
 		err = json.Unmarshal(turnsJSON, &match.Turns)
 		if err != nil {
-			return domain.Match{}, fmt.Errorf("failed to unmarshal turns: %v", err)
+			return domain.Match{}, wrapError(err, "failed to unmarshal turns")
 		}
 	} else {
-		match.Turns = []domain.Turn{}
-		rows, err := r.db.QueryContext(ctx, 
-			`SELECT id, turn_number, time, player, ball_power, routine_id, match_number 
-             FROM turns WHERE match_id = ? ORDER BY turn_number`, id)
+		match.Turns, err = queryTurns(ctx, r.conn, id)
 		if err != nil {
-			return domain.Match{}, fmt.Errorf("failed to fetch turns: %v", err)
-		}
-		defer rows.Close()
-
-		for rows.Next() {
-			var turn domain.Turn
-			err := rows.Scan(&turn.ID, &turn.TurnNumber, &turn.Time, &turn.Player, 
-				&turn.BallPower, &turn.RoutineID, &turn.MatchNumber)
-			if err != nil {
-				return domain.Match{}, fmt.Errorf("failed to scan turn: %v", err)
-			}
-			match.Turns = append(match.Turns, turn)
+			return domain.Match{}, err
 		}
 	}
 
-	log.Printf("✅ Found match with ID %d containing %d turns", match.ID, len(match.Turns))
+	logger.DebugContext(ctx, "✅ Found match", "id", match.ID, "turns", len(match.Turns))
 	return match, nil
 }
 
 func (r *MySQLRepository) GetLastMatch(ctx context.Context) (domain.Match, error) {
-	log.Println("📊 Fetching last match from MySQL")
+	logger.DebugContext(ctx, "📊 Fetching last match")
 
-	var id int
-	err := r.db.QueryRowContext(ctx, "SELECT MAX(id) FROM matches").Scan(&id)
+	var id sql.NullInt64
+	err := r.conn.QueryRowContext(ctx, "SELECT MAX(id) FROM matches").Scan(&id)
 	if err != nil {
-		return domain.Match{}, fmt.Errorf("failed to get last match ID: %v", err)
+		return domain.Match{}, wrapError(err, "failed to get last match ID")
+	}
+	if !id.Valid {
+		return domain.Match{}, fmt.Errorf("no matches stored: %w", domain.ErrMatchNotFound)
 	}
 
-	return r.GetMatchByID(ctx, id)
+	return r.GetMatchByID(ctx, int(id.Int64))
 }
 
-func (r *MySQLRepository) TestConnection(ctx context.Context) error {
-	log.Println("🧪 Testing MySQL connection...")
-	err := r.db.PingContext(ctx)
+func (r *MySQLRepository) queryMatchIDs(ctx context.Context, query string, args ...any) ([]int, error) {
+	rows, err := r.conn.QueryContext(ctx, query, args...)
 	if err != nil {
-		log.Printf("❌ Failed to ping MySQL: %v", err)
-		return err
+		return nil, wrapError(err, "failed to query matches")
 	}
+	defer rows.Close()
 
-	_, err = r.db.ExecContext(ctx, 
-		"INSERT INTO matches (match_number, start_time) VALUES (?, ?)", 
-		0, time.Now())
+	var ids []int
+	for rows.Next() {
+		var id int
+		if err := rows.Scan(&id); err != nil {
+			return nil, wrapError(err, "failed to scan match ID")
+		}
+		ids = append(ids, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, wrapError(err, "failed to read matches")
+	}
+
+	return ids, nil
+}
+
+func (r *MySQLRepository) StreamMatches(ctx context.Context, filter domain.MatchFilter, fn func(domain.Match) error) error {
+	logger.DebugContext(ctx, "📊 Streaming matches")
+
+	query := "SELECT id FROM matches m WHERE match_number > 0"
+	var args []any
+	if !filter.From.IsZero() {
+		query += " AND start_time >= ?"
+		args = append(args, filter.From)
+	}
+	if !filter.To.IsZero() {
+		query += " AND start_time < ?"
+		args = append(args, filter.To)
+	}
+	if filter.Player != "" {
+		query += " AND EXISTS (SELECT 1 FROM turns t WHERE t.match_id = m.id AND t.player = ?)"
+		args = append(args, filter.Player)
+	}
+	if filter.Limit > 0 {
+		query += " ORDER BY id DESC LIMIT ?"
+		args = append(args, filter.Limit)
+	} else {
+		query += " ORDER BY id"
+	}
+
+	ids, err := r.queryMatchIDs(ctx, query, args...)
 	if err != nil {
-		log.Printf("❌ Failed to insert test record: %v", err)
 		return err
 	}
+	if filter.Limit > 0 {
+		slices.Reverse(ids)
+	}
+
+	for _, id := range ids {
+		match, err := r.GetMatchByID(ctx, id)
+		if err != nil {
+			return err
+		}
+		if err := fn(match); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *MySQLRepository) MatchExistsByRoutineID(ctx context.Context, routineID string) (bool, error) {
+	var exists bool
+	err := r.conn.QueryRowContext(ctx,
+		"SELECT EXISTS (SELECT 1 FROM turns WHERE routine_id = ?)", routineID).Scan(&exists)
+	if err != nil {
+		return false, wrapError(err, fmt.Sprintf("failed to check routine ID %s", routineID))
+	}
+
+	return exists, nil
+}
+
+func (r *MySQLRepository) GetPlayerStats(ctx context.Context, player string) (domain.PlayerSummary, error) {
+	logger.DebugContext(ctx, "📊 Fetching player stats", "player", player)
+
+	summary := domain.PlayerSummary{Player: player}
+	var averagePower sql.NullFloat64
+	err := r.conn.QueryRowContext(ctx,
+		`SELECT COUNT(DISTINCT match_id), COUNT(*), AVG(ball_power) FROM turns WHERE player = ?`,
+		player).Scan(&summary.Matches, &summary.Hits, &averagePower)
+	if err != nil {
+		return domain.PlayerSummary{}, wrapError(err, "failed to get turn stats")
+	}
+	summary.AverageBallPower = averagePower.Float64
+
+	err = r.conn.QueryRowContext(ctx,
+		`SELECT COALESCE(SUM(m.winner = ?), 0),
+		        COALESCE(SUM(m.winner IN ('A', 'B') AND m.winner <> ?), 0),
+		        COALESCE(SUM(m.winner = 'Draw'), 0)
+		 FROM matches m
+		 WHERE m.end_time IS NOT NULL
+		   AND EXISTS (SELECT 1 FROM turns t WHERE t.match_id = m.id AND t.player = ?)`,
+		player, player, player).Scan(&summary.Wins, &summary.Losses, &summary.Draws)
+	if err != nil {
+		return domain.PlayerSummary{}, wrapError(err, "failed to get result stats")
+	}
+
+	return summary, nil
+}
+
+func (r *MySQLRepository) GetMaxMatchNumber(ctx context.Context) (int, error) {
+	var matchNumber sql.NullInt64
+	err := r.conn.QueryRowContext(ctx, "SELECT MAX(match_number) FROM matches").Scan(&matchNumber)
+	if err != nil {
+		return 0, wrapError(err, "failed to get max match number")
+	}
 
-	log.Println("✅ MySQL connection test successful")
+	return int(matchNumber.Int64), nil
+}
+
+func (r *MySQLRepository) GetUnfinishedMatches(ctx context.Context) ([]domain.Match, error) {
+	logger.DebugContext(ctx, "📊 Fetching unfinished matches")
+
+	ids, err := r.queryMatchIDs(ctx,
+		"SELECT id FROM matches WHERE end_time IS NULL AND match_number > 0 ORDER BY id")
+	if err != nil {
+		return nil, err
+	}
+
+	matches := make([]domain.Match, 0, len(ids))
+	for _, id := range ids {
+		match, err := r.GetMatchByID(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		matches = append(matches, match)
+	}
+
+	logger.DebugContext(ctx, "✅ Found unfinished matches", "count", len(matches))
+	return matches, nil
+}
+
+func (r *MySQLRepository) SaveDeadLetter(ctx context.Context, letter domain.DeadLetter) error {
+	_, err := r.conn.ExecContext(ctx,
+		`INSERT INTO webhook_dead_letters (url, payload, attempts, last_error, created_at)
+		 VALUES (?, ?, ?, ?, ?)`,
+		letter.URL, letter.Payload, letter.Attempts, letter.LastError, letter.CreatedAt)
+	if err != nil {
+		return wrapError(err, "failed to save dead letter")
+	}
+
+	logger.InfoContext(ctx, "📪 Saved undelivered webhook", "url", letter.URL)
+	return nil
+}
+
+func (r *MySQLRepository) TestConnection(ctx context.Context) error {
+	logger.DebugContext(ctx, "🧪 Testing MySQL connection...")
+	err := r.db.PingContext(ctx)
+	if err != nil {
+		logger.ErrorContext(ctx, "❌ Failed to ping MySQL", "err", err)
+		return fmt.Errorf("failed to ping MySQL: %w: %v", domain.ErrStorageUnavailable, err)
+	}
+
+	logger.DebugContext(ctx, "✅ MySQL connection test successful")
 	return nil
-}
\ No newline at end of file
+}
+
+func (r *MySQLRepository) CheckTables(ctx context.Context) error {
+	for _, table := range []string{"matches", "turns", "match_events", "schedules", "schema_version"} {
+		rows, err := r.db.QueryContext(ctx, "SELECT 1 FROM "+table+" LIMIT 0")
+		if err != nil {
+			return wrapError(err, fmt.Sprintf("table %s is not reachable", table))
+		}
+		rows.Close()
+	}
+
+	return nil
+}
+
+// DeletePlaceholderMatches removes the empty matches the old TestConnection
+// inserted with match number 0, and returns how many it removed.
+func (r *MySQLRepository) DeletePlaceholderMatches(ctx context.Context) (int64, error) {
+	result, err := r.db.ExecContext(ctx, `
+		DELETE FROM matches WHERE match_number = 0 AND end_time IS NULL AND turns IS NULL
+			AND id NOT IN (SELECT match_id FROM turns)
+			AND id NOT IN (SELECT match_id FROM match_events)`)
+	if err != nil {
+		return 0, wrapError(err, "failed to delete placeholder matches")
+	}
+
+	return result.RowsAffected()
+}
+
+func (r *MySQLRepository) SchemaVersion(ctx context.Context) (int, error) {
+	return currentSchemaVersion(ctx, r.db)
+}
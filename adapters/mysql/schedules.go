@@ -0,0 +1,63 @@
+package mysql
+
+import (
+	"context"
+	"fmt"
+
+	"pingpong/domain"
+)
+
+func (r *MySQLRepository) CreateSchedule(ctx context.Context, schedule domain.Schedule) (domain.Schedule, error) {
+	result, err := r.conn.ExecContext(ctx,
+		"INSERT INTO schedules (name, cron, matches, created_at) VALUES (?, ?, ?, ?)",
+		schedule.Name, schedule.Cron, schedule.Matches, schedule.CreatedAt)
+	if err != nil {
+		return domain.Schedule{}, wrapError(err, "failed to create schedule")
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return domain.Schedule{}, wrapError(err, "failed to get last insert ID")
+	}
+	schedule.ID = int(id)
+	return schedule, nil
+}
+
+func (r *MySQLRepository) ListSchedules(ctx context.Context) ([]domain.Schedule, error) {
+	rows, err := r.conn.QueryContext(ctx,
+		"SELECT id, name, cron, matches, created_at FROM schedules ORDER BY id")
+	if err != nil {
+		return nil, wrapError(err, "failed to fetch schedules")
+	}
+	defer rows.Close()
+
+	schedules := []domain.Schedule{}
+	for rows.Next() {
+		var s domain.Schedule
+		if err := rows.Scan(&s.ID, &s.Name, &s.Cron, &s.Matches, &s.CreatedAt); err != nil {
+			return nil, wrapError(err, "failed to scan schedule")
+		}
+		schedules = append(schedules, s)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, wrapError(err, "failed to read schedules")
+	}
+
+	return schedules, nil
+}
+
+func (r *MySQLRepository) DeleteSchedule(ctx context.Context, id int) error {
+	result, err := r.conn.ExecContext(ctx, "DELETE FROM schedules WHERE id = ?", id)
+	if err != nil {
+		return wrapError(err, "failed to delete schedule")
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return wrapError(err, "failed to get affected rows")
+	}
+	if affected == 0 {
+		return fmt.Errorf("schedule with ID %d: %w", id, domain.ErrScheduleNotFound)
+	}
+	return nil
+}
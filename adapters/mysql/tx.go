@@ -0,0 +1,45 @@
+package mysql
+
+import (
+	"context"
+	"database/sql"
+
+	"pingpong/ports"
+)
+
+// conn runs the repository's queries: the connection pool, or the
+// transaction of a repository handed out by Transaction.
+type conn interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+}
+
+// Transaction runs fn with a repository whose writes are committed together
+// when fn returns nil and rolled back otherwise.
+func (r *MySQLRepository) Transaction(ctx context.Context, fn func(repo ports.MatchRepository) error) error {
+	return r.inTx(ctx, func(tx *sql.Tx) error {
+		return fn(&MySQLRepository{db: r.db, conn: tx, tx: tx})
+	})
+}
+
+// inTx runs fn in the transaction r belongs to, or else in a new one.
+func (r *MySQLRepository) inTx(ctx context.Context, fn func(tx *sql.Tx) error) error {
+	if r.tx != nil {
+		return fn(r.tx)
+	}
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return wrapError(err, "failed to begin transaction")
+	}
+	defer tx.Rollback()
+
+	if err := fn(tx); err != nil {
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		return wrapError(err, "failed to commit transaction")
+	}
+	return nil
+}
@@ -0,0 +1,91 @@
+package mysql
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"pingpong/domain"
+	"pingpong/ports"
+)
+
+// UnavailableRepository stands in for a database that could not be reached
+// at startup. Every call fails with domain.ErrStorageUnavailable, so clients
+// get a retryable error instead of the service crashing.
+type UnavailableRepository struct {
+	err error
+}
+
+// NewUnavailableRepository returns a repository whose calls all fail because
+// of cause.
+func NewUnavailableRepository(cause error) *UnavailableRepository {
+	return &UnavailableRepository{err: fmt.Errorf("%w: %v", domain.ErrStorageUnavailable, cause)}
+}
+
+func (r *UnavailableRepository) SaveMatch(ctx context.Context, match domain.Match) error {
+	return r.err
+}
+
+func (r *UnavailableRepository) CreateMatch(ctx context.Context, match domain.Match) (int, error) {
+	return 0, r.err
+}
+
+func (r *UnavailableRepository) AppendTurn(ctx context.Context, matchID int, turn domain.Turn) error {
+	return r.err
+}
+
+func (r *UnavailableRepository) FinishMatch(ctx context.Context, matchID int, endTime time.Time, winner string) error {
+	return r.err
+}
+
+func (r *UnavailableRepository) AppendEvent(ctx context.Context, event domain.Event) (domain.Event, error) {
+	return domain.Event{}, r.err
+}
+
+func (r *UnavailableRepository) ListEvents(ctx context.Context, matchID int) ([]domain.Event, error) {
+	return nil, r.err
+}
+
+func (r *UnavailableRepository) GetMatchByID(ctx context.Context, id int) (domain.Match, error) {
+	return domain.Match{}, r.err
+}
+
+func (r *UnavailableRepository) GetLastMatch(ctx context.Context) (domain.Match, error) {
+	return domain.Match{}, r.err
+}
+
+func (r *UnavailableRepository) StreamMatches(ctx context.Context, filter domain.MatchFilter, fn func(domain.Match) error) error {
+	return r.err
+}
+
+func (r *UnavailableRepository) MatchExistsByRoutineID(ctx context.Context, routineID string) (bool, error) {
+	return false, r.err
+}
+
+func (r *UnavailableRepository) GetPlayerStats(ctx context.Context, player string) (domain.PlayerSummary, error) {
+	return domain.PlayerSummary{}, r.err
+}
+
+func (r *UnavailableRepository) GetMaxMatchNumber(ctx context.Context) (int, error) {
+	return 0, r.err
+}
+
+func (r *UnavailableRepository) GetUnfinishedMatches(ctx context.Context) ([]domain.Match, error) {
+	return nil, r.err
+}
+
+func (r *UnavailableRepository) TestConnection(ctx context.Context) error {
+	return r.err
+}
+
+func (r *UnavailableRepository) CheckTables(ctx context.Context) error {
+	return r.err
+}
+
+func (r *UnavailableRepository) Transaction(ctx context.Context, fn func(repo ports.MatchRepository) error) error {
+	return r.err
+}
+
+func (r *UnavailableRepository) SchemaVersion(ctx context.Context) (int, error) {
+	return 0, r.err
+}
@@ -0,0 +1,65 @@
+package nats
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/nats-io/nats.go"
+
+	"pingpong/domain"
+	"pingpong/logging"
+)
+
+const DefaultSubjectPrefix = "pingpong"
+
+var logger = logging.For(logging.ComponentNATS)
+
+// Publisher sends every match event to <prefix>.matches.<match id>.<type>, so
+// consumers can subscribe to e.g. "pingpong.matches.*.MatchFinished".
+type Publisher struct {
+	conn   *nats.Conn
+	prefix string
+}
+
+func NewPublisher(url string, subjectPrefix string) (*Publisher, error) {
+	conn, err := nats.Connect(url,
+		nats.Name("pingpong"),
+		nats.MaxReconnects(-1),
+		nats.ReconnectWait(2*time.Second),
+		nats.DisconnectErrHandler(func(_ *nats.Conn, err error) {
+			logger.Warn("⚠️ Disconnected from NATS", "err", err)
+		}),
+		nats.ReconnectHandler(func(c *nats.Conn) {
+			logger.Info("🔌 Reconnected to NATS", "url", c.ConnectedUrl())
+		}),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to NATS: %v", err)
+	}
+
+	return &Publisher{conn: conn, prefix: subjectPrefix}, nil
+}
+
+// Subject is where event is published. Events of matches that could not be
+// stored have match ID 0; the routine ID in their payload tells them apart.
+func Subject(prefix string, event domain.Event) string {
+	return fmt.Sprintf("%s.matches.%d.%s", prefix, event.MatchID, event.Type)
+}
+
+func (p *Publisher) Publish(ctx context.Context, event domain.Event) error {
+	data, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("failed to marshal event: %v", err)
+	}
+
+	if err := p.conn.Publish(Subject(p.prefix, event), data); err != nil {
+		return fmt.Errorf("failed to publish event: %v", err)
+	}
+	return nil
+}
+
+func (p *Publisher) Close() error {
+	return p.conn.Drain()
+}
@@ -0,0 +1,72 @@
+package nats
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/nats-io/nats.go"
+
+	"pingpong/domain"
+)
+
+// TestPublisher runs against a real server, e.g. one started with
+// `nats-server -p 4222` and PINGPONG_TEST_NATS_URL=nats://127.0.0.1:4222.
+func TestPublisher(t *testing.T) {
+	url := os.Getenv("PINGPONG_TEST_NATS_URL")
+	if url == "" {
+		t.Skip("PINGPONG_TEST_NATS_URL is not set")
+	}
+
+	prefix := "pingpong-test-" + time.Now().Format("150405.000000")
+	conn, err := nats.Connect(url)
+	if err != nil {
+		t.Fatalf("failed to connect to NATS: %v", err)
+	}
+	defer conn.Close()
+	sub, err := conn.SubscribeSync(prefix + ".matches.*.MatchFinished")
+	if err != nil {
+		t.Fatalf("failed to subscribe: %v", err)
+	}
+	if err := conn.Flush(); err != nil {
+		t.Fatalf("failed to flush subscription: %v", err)
+	}
+
+	publisher, err := NewPublisher(url, prefix)
+	if err != nil {
+		t.Fatalf("NewPublisher: %v", err)
+	}
+	event := domain.Event{
+		MatchID:  7,
+		Sequence: 3,
+		Type:     domain.EventMatchFinished,
+		Time:     time.Now().UTC().Truncate(time.Millisecond),
+		Payload:  domain.EventPayload{Winner: "A", Reason: domain.ReasonWeakReturn},
+	}
+	if err := publisher.Publish(context.Background(), domain.Event{MatchID: 7, Type: domain.EventBallHit}); err != nil {
+		t.Fatalf("Publish BallHit: %v", err)
+	}
+	if err := publisher.Publish(context.Background(), event); err != nil {
+		t.Fatalf("Publish MatchFinished: %v", err)
+	}
+	if err := publisher.Close(); err != nil {
+		t.Fatalf("Close: %v", err)
+	}
+
+	msg, err := sub.NextMsg(5 * time.Second)
+	if err != nil {
+		t.Fatalf("no MatchFinished event received: %v", err)
+	}
+	if want := Subject(prefix, event); msg.Subject != want {
+		t.Errorf("subject = %q, want %q", msg.Subject, want)
+	}
+	var got domain.Event
+	if err := json.Unmarshal(msg.Data, &got); err != nil {
+		t.Fatalf("failed to decode event: %v", err)
+	}
+	if got.MatchID != event.MatchID || got.Sequence != event.Sequence || got.Payload != event.Payload || !got.Time.Equal(event.Time) {
+		t.Errorf("event = %+v, want %+v", got, event)
+	}
+}
@@ -0,0 +1,99 @@
+package rest
+
+import (
+	"embed"
+	"fmt"
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+
+	"golang.org/x/net/websocket"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	pb "pingpong/proto"
+)
+
+//go:embed static
+var static embed.FS
+
+// UpdateSource provides the live match updates also streamed by WatchMatch.
+type UpdateSource interface {
+	SubscribeUpdates() (<-chan *pb.MatchUpdate, func())
+}
+
+func (s *Server) scoreboard(w http.ResponseWriter, r *http.Request) {
+	http.ServeFileFS(w, r, static, "static/scoreboard.html")
+}
+
+// liveUpdates streams protojson-encoded MatchUpdate messages over a WebSocket.
+// A match ID of 0 (or no ID) follows every match.
+func (s *Server) liveUpdates(w http.ResponseWriter, r *http.Request) {
+	var matchID int32
+	if value := r.PathValue("id"); value != "" {
+		id, err := strconv.Atoi(value)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "invalid match ID")
+			return
+		}
+		matchID = int32(id)
+	}
+	if !validate(w, &pb.WatchMatchRequest{MatchId: matchID}) {
+		return
+	}
+
+	websocket.Server{Handshake: s.checkOrigin, Handler: func(ws *websocket.Conn) {
+		defer ws.Close()
+		logger.InfoContext(r.Context(), "👀 Scoreboard connected", "remote_addr", r.RemoteAddr, "match_id", matchID)
+
+		updates, unsubscribe := s.updates.SubscribeUpdates()
+		defer unsubscribe()
+
+		closed := make(chan struct{})
+		go func() {
+			var discard string
+			for websocket.Message.Receive(ws, &discard) == nil {
+			}
+			close(closed)
+		}()
+
+		for {
+			select {
+			case <-closed:
+				logger.InfoContext(r.Context(), "👋 Scoreboard disconnected", "remote_addr", r.RemoteAddr)
+				return
+			case update := <-updates:
+				if matchID != 0 && update.MatchId != matchID {
+					continue
+				}
+				data, err := protojson.Marshal(update)
+				if err != nil {
+					logger.ErrorContext(r.Context(), "❌ Failed to encode match update", "err", err)
+					continue
+				}
+				if err := websocket.Message.Send(ws, string(data)); err != nil {
+					return
+				}
+			}
+		}
+	}}.ServeHTTP(w, r)
+}
+
+// checkOrigin refuses WebSocket handshakes from pages of other sites, so
+// they cannot read the feed with a visitor's access to this server. Clients
+// other than browsers send no Origin and are let through.
+func (s *Server) checkOrigin(config *websocket.Config, r *http.Request) error {
+	origin := r.Header.Get("Origin")
+	if origin == "" {
+		return nil
+	}
+	if u, err := url.Parse(origin); err == nil && u.Host == r.Host {
+		return nil
+	}
+	if slices.Contains(s.AllowedOrigins, origin) {
+		return nil
+	}
+
+	logger.WarnContext(r.Context(), "🚫 Scoreboard origin not allowed", "origin", origin, "remote_addr", r.RemoteAddr)
+	return fmt.Errorf("origin %q is not allowed", origin)
+}
@@ -0,0 +1,256 @@
+package rest
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	protobuf "google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	grpcAdapter "pingpong/adapters/grpc"
+	"pingpong/domain"
+	"pingpong/logging"
+	"pingpong/ports"
+	pb "pingpong/proto"
+)
+
+var logger = logging.For(logging.ComponentREST)
+
+// Server exposes the PlayerService routes declared in pingpong.proto as
+// plain HTTP/JSON. Bodies use the same protojson encoding as the OpenAPI
+// document served at /openapi.json.
+type Server struct {
+	matchService ports.MatchService
+	updates      UpdateSource
+	mux          *http.ServeMux
+	// AllowedOrigins are the origins, such as https://scores.example.com,
+	// whose pages may open the live feed besides pages served from this
+	// server.
+	AllowedOrigins []string
+}
+
+// NewServer builds the HTTP API. When updates is not nil it also serves the
+// live scoreboard page and its WebSocket feed.
+func NewServer(matchService ports.MatchService, updates UpdateSource) *Server {
+	s := &Server{
+		matchService: matchService,
+		updates:      updates,
+		mux:          http.NewServeMux(),
+	}
+
+	s.mux.HandleFunc("GET /matches", s.listMatches)
+	s.mux.HandleFunc("GET /matches/latest", s.getLatestMatch)
+	s.mux.HandleFunc("GET /matches/{id}", s.getMatch)
+	s.mux.HandleFunc("POST /matches", s.saveMatch)
+	s.mux.HandleFunc("GET /players/{id}/stats", s.getPlayerStats)
+	s.mux.HandleFunc("GET /openapi.json", s.openAPI)
+
+	if updates != nil {
+		s.mux.HandleFunc("GET /{$}", s.scoreboard)
+		s.mux.HandleFunc("GET /scoreboard", s.scoreboard)
+		s.mux.HandleFunc("GET /ws/matches", s.liveUpdates)
+		s.mux.HandleFunc("GET /ws/matches/{id}", s.liveUpdates)
+	}
+
+	return s
+}
+
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mux.ServeHTTP(w, r)
+}
+
+func (s *Server) listMatches(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	req := &pb.ListMatchesRequest{Player: query.Get("player")}
+
+	for name, target := range map[string]**timestamppb.Timestamp{"from": &req.From, "to": &req.To} {
+		if value := query.Get(name); value != "" {
+			t, err := time.Parse(time.RFC3339, value)
+			if err != nil {
+				writeError(w, http.StatusBadRequest, "invalid "+name+": expected RFC3339 time")
+				return
+			}
+			*target = timestamppb.New(t)
+		}
+	}
+	if value := query.Get("limit"); value != "" {
+		limit, err := strconv.Atoi(value)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "invalid limit")
+			return
+		}
+		req.Limit = int32(limit)
+	}
+	if !validate(w, req) {
+		return
+	}
+
+	filter := grpcAdapter.ProtoToDomainMatchFilter(req)
+	if filter.Limit <= 0 {
+		filter.Limit = grpcAdapter.DefaultListLimit
+	}
+
+	res := &pb.ListMatchesResponse{}
+	err := s.matchService.StreamMatches(r.Context(), filter, func(match domain.Match) error {
+		res.Matches = append(res.Matches, grpcAdapter.DomainMatchToProto(match))
+		return nil
+	})
+	if err != nil {
+		logger.ErrorContext(r.Context(), "❌ Failed to list matches", "err", err)
+		writeError(w, httpStatus(err), "failed to list matches")
+		return
+	}
+
+	writeProto(w, http.StatusOK, res)
+}
+
+func (s *Server) getLatestMatch(w http.ResponseWriter, r *http.Request) {
+	match, err := s.matchService.GetLastMatch(r.Context())
+	if err != nil {
+		logger.WarnContext(r.Context(), "❌ No match data available", "err", err)
+		writeError(w, httpStatus(err), "no match data available")
+		return
+	}
+
+	writeProto(w, http.StatusOK, grpcAdapter.DomainMatchToProto(match))
+}
+
+func (s *Server) getMatch(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "invalid match ID")
+		return
+	}
+	if !validate(w, &pb.GetMatchByIDRequest{Id: int32(id)}) {
+		return
+	}
+
+	match, err := s.matchService.GetMatchByID(r.Context(), id)
+	if err != nil {
+		logger.WarnContext(r.Context(), "❌ Match not found", "match_id", id, "err", err)
+		writeError(w, httpStatus(err), "match not found")
+		return
+	}
+
+	writeProto(w, http.StatusOK, grpcAdapter.DomainMatchToProto(match))
+}
+
+func (s *Server) saveMatch(w http.ResponseWriter, r *http.Request) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "failed to read body")
+		return
+	}
+
+	var pbMatch pb.Match
+	if err := protojson.Unmarshal(body, &pbMatch); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid match: "+err.Error())
+		return
+	}
+	if !validate(w, &pbMatch) {
+		return
+	}
+
+	if err := s.matchService.SaveMatch(r.Context(), grpcAdapter.ProtoToDomainMatch(&pbMatch)); err != nil {
+		logger.ErrorContext(r.Context(), "❌ Failed to save match", "err", err)
+		writeError(w, httpStatus(err), "failed to save match")
+		return
+	}
+
+	writeProto(w, http.StatusCreated, &pb.SaveMatchResponse{Message: "Match saved successfully"})
+}
+
+func (s *Server) getPlayerStats(w http.ResponseWriter, r *http.Request) {
+	player := r.PathValue("id")
+	if !validate(w, &pb.GetPlayerStatsRequest{PlayerId: player}) {
+		return
+	}
+
+	summary, err := s.matchService.GetPlayerStats(r.Context(), player)
+	if err != nil {
+		logger.WarnContext(r.Context(), "❌ Player stats not available", "player", player, "err", err)
+		writeError(w, httpStatus(err), "player stats not available")
+		return
+	}
+	if summary.Matches == 0 {
+		writeError(w, http.StatusNotFound, "player has no matches")
+		return
+	}
+
+	writeProto(w, http.StatusOK, grpcAdapter.DomainPlayerSummaryToProto(summary))
+}
+
+func (s *Server) openAPI(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Write(pb.OpenAPI)
+}
+
+// httpStatus mirrors grpcAdapter.StatusCode for HTTP clients.
+func httpStatus(err error) int {
+	switch {
+	case errors.Is(err, domain.ErrMatchNotFound), errors.Is(err, domain.ErrScheduleNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, domain.ErrStorageUnavailable), errors.Is(err, domain.ErrShuttingDown):
+		return http.StatusServiceUnavailable
+	case errors.Is(err, domain.ErrInvalidArgument):
+		return http.StatusBadRequest
+	case errors.Is(err, domain.ErrNoActiveMatch), errors.Is(err, domain.ErrNoShotPending),
+		errors.Is(err, domain.ErrMatchInProgress):
+		return http.StatusConflict
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+func writeProto(w http.ResponseWriter, status int, msg protobuf.Message) {
+	data, err := protojson.Marshal(msg)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "failed to encode response")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	w.Write(data)
+}
+
+// writeError follows the code/message shape of the rpcStatus definition in the
+// OpenAPI document, with the HTTP status as the code.
+func writeError(w http.ResponseWriter, status int, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(map[string]any{
+		"code":    status,
+		"message": message,
+	})
+}
+
+// validate applies the same field rules as the gRPC interceptor and, on
+// failure, writes a 400 whose details hold a google.rpc.BadRequest.
+func validate(w http.ResponseWriter, msg protobuf.Message) bool {
+	violations := pb.Validate(msg)
+	if len(violations) == 0 {
+		return true
+	}
+
+	fields := make([]map[string]string, len(violations))
+	for i, v := range violations {
+		fields[i] = map[string]string{"field": v.Field, "description": v.Description}
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusBadRequest)
+	json.NewEncoder(w).Encode(map[string]any{
+		"code":    http.StatusBadRequest,
+		"message": "invalid " + string(msg.ProtoReflect().Descriptor().Name()) + ": " + violations[0].Description,
+		"details": []map[string]any{{
+			"@type":           "type.googleapis.com/google.rpc.BadRequest",
+			"fieldViolations": fields,
+		}},
+	})
+	return false
+}
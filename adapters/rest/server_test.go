@@ -0,0 +1,109 @@
+package rest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"golang.org/x/net/websocket"
+
+	"pingpong/domain"
+	"pingpong/ports"
+	pb "pingpong/proto"
+)
+
+type stubMatchService struct {
+	ports.MatchService
+	err error
+}
+
+func (s stubMatchService) GetMatchByID(ctx context.Context, id int) (domain.Match, error) {
+	return domain.Match{}, s.err
+}
+
+func TestHTTPStatus(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want int
+	}{
+		{"match not found", domain.ErrMatchNotFound, http.StatusNotFound},
+		{"schedule not found", domain.ErrScheduleNotFound, http.StatusNotFound},
+		{"storage unavailable", fmt.Errorf("ping: %w", domain.ErrStorageUnavailable), http.StatusServiceUnavailable},
+		{"shutting down", domain.ErrShuttingDown, http.StatusServiceUnavailable},
+		{"invalid argument", domain.ErrInvalidArgument, http.StatusBadRequest},
+		{"no active match", domain.ErrNoActiveMatch, http.StatusConflict},
+		{"no shot pending", domain.ErrNoShotPending, http.StatusConflict},
+		{"match in progress", domain.ErrMatchInProgress, http.StatusConflict},
+		{"internal", errors.New("boom"), http.StatusInternalServerError},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := httpStatus(tt.err); got != tt.want {
+				t.Errorf("httpStatus() = %d, want %d", got, tt.want)
+			}
+
+			server := NewServer(stubMatchService{err: tt.err}, nil)
+			rec := httptest.NewRecorder()
+			server.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/matches/7", nil))
+
+			if rec.Code != tt.want {
+				t.Errorf("GET /matches/7 status = %d, want %d", rec.Code, tt.want)
+			}
+			var body struct {
+				Code    int    `json:"code"`
+				Message string `json:"message"`
+			}
+			if err := json.NewDecoder(rec.Body).Decode(&body); err != nil {
+				t.Fatalf("failed to decode error body: %v", err)
+			}
+			if body.Code != tt.want || body.Message == "" {
+				t.Errorf("body = %+v, want code %d and a message", body, tt.want)
+			}
+		})
+	}
+}
+
+type noUpdates struct{}
+
+func (noUpdates) SubscribeUpdates() (<-chan *pb.MatchUpdate, func()) {
+	return make(chan *pb.MatchUpdate), func() {}
+}
+
+func TestLiveUpdatesChecksOrigin(t *testing.T) {
+	server := NewServer(stubMatchService{}, noUpdates{})
+	server.AllowedOrigins = []string{"https://scores.example.com"}
+	ts := httptest.NewServer(server)
+	defer ts.Close()
+
+	tests := []struct {
+		name   string
+		origin string
+		ok     bool
+	}{
+		{"same origin", ts.URL, true},
+		{"allowed origin", "https://scores.example.com", true},
+		{"other origin", "https://evil.example.com", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config, err := websocket.NewConfig("ws"+strings.TrimPrefix(ts.URL, "http")+"/ws/matches", tt.origin)
+			if err != nil {
+				t.Fatal(err)
+			}
+			ws, err := websocket.DialConfig(config)
+			if err == nil {
+				ws.Close()
+			}
+			if (err == nil) != tt.ok {
+				t.Errorf("dial from %s: err = %v, want success %t", tt.origin, err, tt.ok)
+			}
+		})
+	}
+}
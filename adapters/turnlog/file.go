@@ -0,0 +1,108 @@
+package turnlog
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"os"
+)
+
+type Rotation struct {
+	MaxBytes   int64
+	MaxBackups int
+}
+
+// rotatingFile appends to an existing log on start and, when MaxBytes is set,
+// moves it aside to path.1, path.2, ... before it grows past the limit.
+type rotatingFile struct {
+	path     string
+	rotation Rotation
+	header   []byte
+	f        *os.File
+	w        *bufio.Writer
+	size     int64
+}
+
+func openRotatingFile(path string, rotation Rotation, header []byte) (*rotatingFile, error) {
+	r := &rotatingFile{path: path, rotation: rotation, header: header}
+	if err := r.open(); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+func (r *rotatingFile) open() error {
+	f, err := os.OpenFile(r.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return fmt.Errorf("failed to open turn log %s: %v", r.path, err)
+	}
+	info, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return fmt.Errorf("failed to stat turn log %s: %v", r.path, err)
+	}
+
+	r.f = f
+	r.w = bufio.NewWriter(f)
+	r.size = info.Size()
+
+	if r.size == 0 && len(r.header) > 0 {
+		n, err := r.w.Write(r.header)
+		r.size += int64(n)
+		return err
+	}
+	return nil
+}
+
+// Write appends p, rotating the log first when p would take it past
+// MaxBytes. p is written even when rotating fails, and rotation is tried
+// again on the next write.
+func (r *rotatingFile) Write(p []byte) error {
+	var rotateErr error
+	if r.rotation.MaxBytes > 0 && r.size+int64(len(p)) > r.rotation.MaxBytes && r.size > int64(len(r.header)) {
+		rotateErr = r.rotate()
+	}
+
+	n, err := r.w.Write(p)
+	r.size += int64(n)
+	return errors.Join(rotateErr, err)
+}
+
+// rotate moves the log aside and starts a new one. When the log cannot be
+// moved it is reopened where it is, so writes never go to a closed file.
+func (r *rotatingFile) rotate() error {
+	if err := r.Close(); err != nil {
+		return errors.Join(err, r.open())
+	}
+	if err := r.moveAside(); err != nil {
+		return errors.Join(err, r.open())
+	}
+	return r.open()
+}
+
+func (r *rotatingFile) moveAside() error {
+	if r.rotation.MaxBackups > 0 {
+		os.Remove(fmt.Sprintf("%s.%d", r.path, r.rotation.MaxBackups))
+		for i := r.rotation.MaxBackups - 1; i >= 1; i-- {
+			os.Rename(fmt.Sprintf("%s.%d", r.path, i), fmt.Sprintf("%s.%d", r.path, i+1))
+		}
+		if err := os.Rename(r.path, r.path+".1"); err != nil {
+			return fmt.Errorf("failed to rotate turn log %s: %v", r.path, err)
+		}
+	} else if err := os.Remove(r.path); err != nil {
+		return fmt.Errorf("failed to rotate turn log %s: %v", r.path, err)
+	}
+	return nil
+}
+
+func (r *rotatingFile) Flush() error {
+	return r.w.Flush()
+}
+
+func (r *rotatingFile) Close() error {
+	if err := r.w.Flush(); err != nil {
+		r.f.Close()
+		return err
+	}
+	return r.f.Close()
+}
@@ -0,0 +1,66 @@
+package turnlog
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestRotatingFileKeepsWritingWhenRotationFails(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "turns.log")
+	// A non-empty directory where the backup goes makes the rename fail.
+	if err := os.MkdirAll(filepath.Join(path+".1", "taken"), 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	f, err := openRotatingFile(path, Rotation{MaxBytes: 8, MaxBackups: 1}, nil)
+	if err != nil {
+		t.Fatalf("openRotatingFile: %v", err)
+	}
+	if err := f.Write([]byte("first\n")); err != nil {
+		t.Fatalf("first write: %v", err)
+	}
+	if err := f.Write([]byte("second\n")); err == nil {
+		t.Error("write needing a failed rotation returned no error")
+	}
+	if err := f.Write([]byte("third\n")); err == nil {
+		t.Error("write retrying the failed rotation returned no error")
+	}
+	if err := f.Close(); err != nil {
+		t.Fatalf("Close: %v", err)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := string(data), "first\nsecond\nthird\n"; got != want {
+		t.Errorf("log = %q, want %q", got, want)
+	}
+}
+
+func TestRotatingFileRotates(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "turns.log")
+	f, err := openRotatingFile(path, Rotation{MaxBytes: 8, MaxBackups: 1}, []byte("h\n"))
+	if err != nil {
+		t.Fatalf("openRotatingFile: %v", err)
+	}
+	for _, line := range []string{"first\n", "second\n"} {
+		if err := f.Write([]byte(line)); err != nil {
+			t.Fatalf("write %q: %v", line, err)
+		}
+	}
+	if err := f.Close(); err != nil {
+		t.Fatalf("Close: %v", err)
+	}
+
+	for name, want := range map[string]string{path: "h\nsecond\n", path + ".1": "h\nfirst\n"} {
+		data, err := os.ReadFile(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(data) != want {
+			t.Errorf("%s = %q, want %q", filepath.Base(name), data, want)
+		}
+	}
+}
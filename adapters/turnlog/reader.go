@@ -0,0 +1,126 @@
+package turnlog
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"sort"
+	"strconv"
+	"time"
+
+	"pingpong/adapters/export"
+	"pingpong/domain"
+)
+
+// turnLimit mirrors the rule in PlayerBPing: once Player B has received the
+// ball more than this many turns into a match, the winner is decided by
+// comparing powers, which are not recorded in the log. Logs written under a
+// configured turn limit other than the default are inferred as if it applied.
+const turnLimit = domain.DefaultTurnLimit
+
+// ReadTurns parses a match_log.csv file. Repeated header lines, which appear
+// when several logs were concatenated, are skipped.
+func ReadTurns(r io.Reader) ([]domain.Turn, error) {
+	cr := csv.NewReader(r)
+	cr.FieldsPerRecord = len(export.CSVHeader)
+
+	var turns []domain.Turn
+	for line := 1; ; line++ {
+		record, err := cr.Read()
+		if errors.Is(err, io.EOF) {
+			return turns, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		if slices.Equal(record, export.CSVHeader) {
+			continue
+		}
+
+		turn, err := parseTurn(record)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %v", line, err)
+		}
+		turns = append(turns, turn)
+	}
+}
+
+func parseTurn(record []string) (domain.Turn, error) {
+	t, err := time.Parse(time.RFC3339, record[0])
+	if err != nil {
+		return domain.Turn{}, fmt.Errorf("invalid time: %v", err)
+	}
+	turnNumber, err := strconv.Atoi(record[1])
+	if err != nil {
+		return domain.Turn{}, fmt.Errorf("invalid turn_number: %v", err)
+	}
+	ballPower, err := strconv.Atoi(record[3])
+	if err != nil {
+		return domain.Turn{}, fmt.Errorf("invalid ball_power: %v", err)
+	}
+	matchNumber, err := strconv.Atoi(record[5])
+	if err != nil {
+		return domain.Turn{}, fmt.Errorf("invalid match_number: %v", err)
+	}
+
+	return domain.Turn{
+		TurnNumber:  turnNumber,
+		Time:        t,
+		Player:      record[2],
+		BallPower:   ballPower,
+		RoutineID:   record[4],
+		MatchNumber: matchNumber,
+	}, nil
+}
+
+// GroupMatches rebuilds one match per routine ID, ordered by start time.
+// Duplicate turns (same routine and turn number) are kept only once.
+func GroupMatches(turns []domain.Turn) []domain.Match {
+	byRoutine := map[string]map[int]domain.Turn{}
+	for _, turn := range turns {
+		if byRoutine[turn.RoutineID] == nil {
+			byRoutine[turn.RoutineID] = map[int]domain.Turn{}
+		}
+		byRoutine[turn.RoutineID][turn.TurnNumber] = turn
+	}
+
+	matches := make([]domain.Match, 0, len(byRoutine))
+	for _, routineTurns := range byRoutine {
+		match := domain.Match{}
+		for _, turn := range routineTurns {
+			match.Turns = append(match.Turns, turn)
+		}
+		sort.Slice(match.Turns, func(i, j int) bool {
+			return match.Turns[i].TurnNumber < match.Turns[j].TurnNumber
+		})
+
+		first, last := match.Turns[0], match.Turns[len(match.Turns)-1]
+		match.MatchNumber = first.MatchNumber
+		match.StartTime = first.Time
+		match.EndTime = last.Time
+		match.Winner = inferWinner(last)
+		matches = append(matches, match)
+	}
+
+	sort.Slice(matches, func(i, j int) bool {
+		return matches[i].StartTime.Before(matches[j].StartTime)
+	})
+	return matches
+}
+
+// inferWinner applies the server's rules to the last logged turn. A match only
+// ends on Player B's turn: within the turn limit B can only lose, past it the
+// result depended on an unlogged power, and a match ending on A's turn was
+// never finished.
+func inferWinner(last domain.Turn) string {
+	switch {
+	case last.Player != "B":
+		return domain.WinnerAborted
+	case last.TurnNumber <= turnLimit:
+		return "A"
+	default:
+		return ""
+	}
+}
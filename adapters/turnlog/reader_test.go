@@ -0,0 +1,101 @@
+package turnlog
+
+import (
+	"strings"
+	"testing"
+
+	"pingpong/domain"
+)
+
+// fixture holds three matches, logged out of order across two concatenated
+// logs: match-2 is won by A within the turn limit, match-3 reached the turn
+// limit on Player B's turn and match-1 stopped on Player A's turn.
+const fixture = `time,turn_number,player,ball_power,routine_id,match_number
+2026-10-19T10:00:05Z,1,B,90,match-2,2
+2026-10-19T10:00:06Z,2,A,70,match-2,2
+2026-10-19T10:00:07Z,3,B,60,match-2,2
+2026-10-19T10:01:00Z,1,B,90,match-3,3
+2026-10-19T10:01:01Z,2,A,80,match-3,3
+2026-10-19T10:01:02Z,3,B,70,match-3,3
+2026-10-19T10:01:03Z,4,A,85,match-3,3
+2026-10-19T10:01:04Z,5,B,75,match-3,3
+2026-10-19T10:01:05Z,6,A,90,match-3,3
+time,turn_number,player,ball_power,routine_id,match_number
+2026-10-19T10:01:05Z,6,A,90,match-3,3
+2026-10-19T10:01:06Z,7,B,80,match-3,3
+2026-10-19T10:01:07Z,8,A,95,match-3,3
+2026-10-19T10:01:08Z,9,B,85,match-3,3
+2026-10-19T10:01:09Z,10,A,99,match-3,3
+2026-10-19T10:01:10Z,11,B,90,match-3,3
+2026-10-19T09:59:00Z,1,B,90,match-1,1
+2026-10-19T09:59:01Z,2,A,70,match-1,1
+`
+
+func TestGroupMatches(t *testing.T) {
+	turns, err := ReadTurns(strings.NewReader(fixture))
+	if err != nil {
+		t.Fatalf("ReadTurns: %v", err)
+	}
+	if len(turns) != 17 {
+		t.Fatalf("read %d turns, want 17", len(turns))
+	}
+
+	matches := GroupMatches(turns)
+	want := []struct {
+		routineID string
+		number    int
+		turns     int
+		winner    string
+	}{
+		{"match-1", 1, 2, domain.WinnerAborted},
+		{"match-2", 2, 3, "A"},
+		{"match-3", 3, domain.DefaultTurnLimit + 1, ""},
+	}
+	if len(matches) != len(want) {
+		t.Fatalf("got %d matches, want %d", len(matches), len(want))
+	}
+	for i, w := range want {
+		match := matches[i]
+		if routineID := match.Turns[0].RoutineID; routineID != w.routineID {
+			t.Errorf("match %d is %s, want %s", i, routineID, w.routineID)
+			continue
+		}
+		if match.MatchNumber != w.number || len(match.Turns) != w.turns || match.Winner != w.winner {
+			t.Errorf("%s: number %d, %d turns, winner %q; want %d, %d turns, winner %q",
+				w.routineID, match.MatchNumber, len(match.Turns), match.Winner, w.number, w.turns, w.winner)
+		}
+		for j, turn := range match.Turns {
+			if turn.TurnNumber != j+1 {
+				t.Errorf("%s: turn %d has number %d", w.routineID, j, turn.TurnNumber)
+			}
+		}
+		if !match.StartTime.Equal(match.Turns[0].Time) || !match.EndTime.Equal(match.Turns[len(match.Turns)-1].Time) {
+			t.Errorf("%s: runs %s to %s, want the times of its first and last turns", w.routineID, match.StartTime, match.EndTime)
+		}
+	}
+}
+
+func TestInferWinner(t *testing.T) {
+	tests := []struct {
+		name string
+		last domain.Turn
+		want string
+	}{
+		{"weak return by B", domain.Turn{TurnNumber: 4, Player: "B"}, "A"},
+		{"B at the turn limit", domain.Turn{TurnNumber: domain.DefaultTurnLimit, Player: "B"}, "A"},
+		{"B past the turn limit", domain.Turn{TurnNumber: domain.DefaultTurnLimit + 1, Player: "B"}, ""},
+		{"stopped on A", domain.Turn{TurnNumber: 3, Player: "A"}, domain.WinnerAborted},
+	}
+	for _, tt := range tests {
+		if got := inferWinner(tt.last); got != tt.want {
+			t.Errorf("%s: inferWinner() = %q, want %q", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestReadTurnsRejectsBadRows(t *testing.T) {
+	_, err := ReadTurns(strings.NewReader("2026-10-19T10:00:00Z,one,A,80,match-1,1\n"))
+	if err == nil || !strings.Contains(err.Error(), "line 1") {
+		t.Errorf("ReadTurns error = %v, want one naming line 1", err)
+	}
+}
@@ -0,0 +1,172 @@
+package turnlog
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+
+	"pingpong/adapters/export"
+	"pingpong/domain"
+	"pingpong/ports"
+)
+
+type CSVSink struct {
+	mu   sync.Mutex
+	file *rotatingFile
+}
+
+func NewCSVSink(path string, rotation Rotation) (*CSVSink, error) {
+	header, err := csvLine(export.CSVHeader)
+	if err != nil {
+		return nil, err
+	}
+	file, err := openRotatingFile(path, rotation, header)
+	if err != nil {
+		return nil, err
+	}
+	return &CSVSink{file: file}, nil
+}
+
+func csvLine(record []string) ([]byte, error) {
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	w.Write(record)
+	w.Flush()
+	return buf.Bytes(), w.Error()
+}
+
+func (s *CSVSink) WriteTurn(turn domain.Turn) error {
+	line, err := csvLine(export.CSVRecord(turn))
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.file.Write(line)
+}
+
+func (s *CSVSink) Flush() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.file.Flush()
+}
+
+func (s *CSVSink) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.file.Close()
+}
+
+type JSONLSink struct {
+	mu   sync.Mutex
+	file *rotatingFile
+}
+
+func NewJSONLSink(path string, rotation Rotation) (*JSONLSink, error) {
+	file, err := openRotatingFile(path, rotation, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &JSONLSink{file: file}, nil
+}
+
+func (s *JSONLSink) WriteTurn(turn domain.Turn) error {
+	line, err := json.Marshal(turn)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.file.Write(append(line, '\n'))
+}
+
+func (s *JSONLSink) Flush() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.file.Flush()
+}
+
+func (s *JSONLSink) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.file.Close()
+}
+
+type NopSink struct{}
+
+func (NopSink) WriteTurn(domain.Turn) error { return nil }
+func (NopSink) Flush() error                { return nil }
+func (NopSink) Close() error                { return nil }
+
+// MultiSink fans every call out to all sinks and joins their errors.
+type MultiSink []ports.TurnSink
+
+func (m MultiSink) WriteTurn(turn domain.Turn) error {
+	var errs []error
+	for _, sink := range m {
+		errs = append(errs, sink.WriteTurn(turn))
+	}
+	return errors.Join(errs...)
+}
+
+func (m MultiSink) Flush() error {
+	var errs []error
+	for _, sink := range m {
+		errs = append(errs, sink.Flush())
+	}
+	return errors.Join(errs...)
+}
+
+func (m MultiSink) Close() error {
+	var errs []error
+	for _, sink := range m {
+		errs = append(errs, sink.Close())
+	}
+	return errors.Join(errs...)
+}
+
+// OpenSinks builds sinks from a comma-separated list of format:path entries,
+// e.g. "csv:match_log.csv,jsonl:turns.jsonl". "none" or an empty spec disables
+// turn logging.
+func OpenSinks(spec string, rotation Rotation) (ports.TurnSink, error) {
+	spec = strings.TrimSpace(spec)
+	if spec == "" || spec == "none" {
+		return NopSink{}, nil
+	}
+
+	var sinks MultiSink
+	for _, entry := range strings.Split(spec, ",") {
+		format, path, ok := strings.Cut(strings.TrimSpace(entry), ":")
+		if !ok || path == "" {
+			sinks.Close()
+			return nil, fmt.Errorf("invalid turn log %q (expected format:path)", entry)
+		}
+
+		var sink ports.TurnSink
+		var err error
+		switch format {
+		case "csv":
+			sink, err = NewCSVSink(path, rotation)
+		case "jsonl":
+			sink, err = NewJSONLSink(path, rotation)
+		default:
+			err = fmt.Errorf("unknown turn log format %q (expected csv or jsonl)", format)
+		}
+		if err != nil {
+			sinks.Close()
+			return nil, err
+		}
+		sinks = append(sinks, sink)
+	}
+
+	if len(sinks) == 1 {
+		return sinks[0], nil
+	}
+	return sinks, nil
+}
@@ -0,0 +1,226 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"pingpong/domain"
+	"pingpong/logging"
+	"pingpong/ports"
+)
+
+var logger = logging.For(logging.ComponentWebhook)
+
+const (
+	SignatureHeader = "X-PingPong-Signature"
+	TimestampHeader = "X-PingPong-Timestamp"
+)
+
+type Config struct {
+	URLs           []string
+	Secret         string
+	MaxAttempts    int
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+	Timeout        time.Duration
+}
+
+func DefaultConfig() Config {
+	return Config{
+		MaxAttempts:    5,
+		InitialBackoff: time.Second,
+		MaxBackoff:     30 * time.Second,
+		Timeout:        5 * time.Second,
+	}
+}
+
+type Payload struct {
+	MatchID     int            `json:"match_id"`
+	MatchNumber int            `json:"match_number"`
+	Players     []string       `json:"players"`
+	Winner      string         `json:"winner"`
+	Score       map[string]int `json:"score"`
+	Turns       int            `json:"turns"`
+	StartTime   time.Time      `json:"start_time"`
+	EndTime     time.Time      `json:"end_time"`
+	DurationMs  int64          `json:"duration_ms"`
+}
+
+func NewPayload(match domain.Match) Payload {
+	score := map[string]int{"A": 0, "B": 0}
+	if _, ok := score[match.Winner]; ok {
+		score[match.Winner] = 1
+	}
+
+	return Payload{
+		MatchID:     match.ID,
+		MatchNumber: match.MatchNumber,
+		Players:     []string{"A", "B"},
+		Winner:      match.Winner,
+		Score:       score,
+		Turns:       len(match.Turns),
+		StartTime:   match.StartTime,
+		EndTime:     match.EndTime,
+		DurationMs:  match.EndTime.Sub(match.StartTime).Milliseconds(),
+	}
+}
+
+// Sign returns the hex HMAC-SHA256 of "<timestamp>.<body>". Receivers should
+// recompute it from the X-PingPong-Timestamp header and the raw body.
+func Sign(secret string, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+type Notifier struct {
+	config      Config
+	client      *http.Client
+	deadLetters ports.DeadLetterStore
+
+	deliveries sync.WaitGroup
+	stop       context.Context
+	stopNow    context.CancelFunc
+}
+
+// NewNotifier fails unless config allows at least one attempt per delivery.
+func NewNotifier(config Config, deadLetters ports.DeadLetterStore) (*Notifier, error) {
+	if config.MaxAttempts < 1 {
+		return nil, fmt.Errorf("webhook max attempts must be at least 1, got %d", config.MaxAttempts)
+	}
+
+	stop, stopNow := context.WithCancel(context.Background())
+	return &Notifier{
+		config:      config,
+		client:      &http.Client{Timeout: config.Timeout},
+		deadLetters: deadLetters,
+		stop:        stop,
+		stopNow:     stopNow,
+	}, nil
+}
+
+// Close waits for deliveries in progress until ctx is done, then gives up on
+// the rest, storing them as dead letters.
+func (n *Notifier) Close(ctx context.Context) error {
+	done := make(chan struct{})
+	go func() {
+		n.deliveries.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		logger.WarnContext(ctx, "⚠️ Giving up on pending webhooks")
+		n.stopNow()
+		<-done
+		return ctx.Err()
+	}
+}
+
+// NotifyMatchFinished delivers the result to every configured URL in the
+// background, so a slow receiver never holds up the next match.
+func (n *Notifier) NotifyMatchFinished(ctx context.Context, match domain.Match) error {
+	body, err := json.Marshal(NewPayload(match))
+	if err != nil {
+		return fmt.Errorf("failed to marshal webhook payload: %v", err)
+	}
+
+	for _, url := range n.config.URLs {
+		n.deliveries.Add(1)
+		go func() {
+			defer n.deliveries.Done()
+
+			ctx, cancel := context.WithCancel(context.WithoutCancel(ctx))
+			defer cancel()
+			defer context.AfterFunc(n.stop, cancel)()
+			n.deliver(ctx, url, body)
+		}()
+	}
+	return nil
+}
+
+func (n *Notifier) deliver(ctx context.Context, url string, body []byte) {
+	backoff := n.config.InitialBackoff
+
+	var lastErr error
+	attempt := 1
+	for ; attempt <= n.config.MaxAttempts; attempt++ {
+		retry, err := n.post(ctx, url, body)
+		if err == nil {
+			logger.InfoContext(ctx, "🪝 Webhook delivered", "url", url)
+			return
+		}
+		lastErr = err
+		logger.WarnContext(ctx, "⚠️ Webhook failed", "url", url, "attempt", attempt, "max_attempts", n.config.MaxAttempts, "err", err)
+
+		if !retry || attempt == n.config.MaxAttempts {
+			break
+		}
+		select {
+		case <-time.After(backoff):
+		case <-ctx.Done():
+		}
+		if ctx.Err() != nil {
+			break
+		}
+		backoff = min(backoff*2, n.config.MaxBackoff)
+	}
+
+	if n.deadLetters == nil {
+		return
+	}
+	err := n.deadLetters.SaveDeadLetter(context.WithoutCancel(ctx), domain.DeadLetter{
+		URL:       url,
+		Payload:   body,
+		Attempts:  attempt,
+		LastError: lastErr.Error(),
+		CreatedAt: time.Now(),
+	})
+	if err != nil {
+		logger.ErrorContext(ctx, "❌ Failed to store undelivered webhook", "url", url, "err", err)
+	}
+}
+
+// post sends one attempt and reports whether a failure is worth retrying:
+// network errors, 429 and 5xx are, other 4xx responses are not.
+func (n *Notifier) post(ctx context.Context, url string, body []byte) (bool, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return false, err
+	}
+
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(TimestampHeader, timestamp)
+	if n.config.Secret != "" {
+		req.Header.Set(SignatureHeader, "sha256="+Sign(n.config.Secret, timestamp, body))
+	}
+
+	resp, err := n.client.Do(req)
+	if err != nil {
+		return true, err
+	}
+	resp.Body.Close()
+
+	switch {
+	case resp.StatusCode >= 200 && resp.StatusCode < 300:
+		return false, nil
+	case resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= 500:
+		return true, fmt.Errorf("unexpected status %s", resp.Status)
+	default:
+		return false, fmt.Errorf("unexpected status %s", resp.Status)
+	}
+}
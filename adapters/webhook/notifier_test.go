@@ -0,0 +1,61 @@
+package webhook
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"pingpong/domain"
+)
+
+type deadLetters struct {
+	letters chan domain.DeadLetter
+}
+
+func (d deadLetters) SaveDeadLetter(ctx context.Context, letter domain.DeadLetter) error {
+	d.letters <- letter
+	return nil
+}
+
+func TestNewNotifierRejectsNoAttempts(t *testing.T) {
+	for _, attempts := range []int{0, -1} {
+		config := DefaultConfig()
+		config.MaxAttempts = attempts
+		if _, err := NewNotifier(config, nil); err == nil {
+			t.Errorf("NewNotifier with %d max attempts succeeded", attempts)
+		}
+	}
+}
+
+func TestNotifierStoresDeadLetterAfterLastAttempt(t *testing.T) {
+	receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer receiver.Close()
+
+	config := DefaultConfig()
+	config.URLs = []string{receiver.URL}
+	config.MaxAttempts = 1
+	store := deadLetters{letters: make(chan domain.DeadLetter, 1)}
+	notifier, err := NewNotifier(config, store)
+	if err != nil {
+		t.Fatalf("NewNotifier: %v", err)
+	}
+
+	if err := notifier.NotifyMatchFinished(context.Background(), domain.Match{ID: 7, Winner: "A"}); err != nil {
+		t.Fatalf("NotifyMatchFinished: %v", err)
+	}
+	select {
+	case letter := <-store.letters:
+		if letter.Attempts != 1 || letter.URL != receiver.URL || letter.LastError == "" {
+			t.Errorf("dead letter = %+v, want 1 attempt to %s with an error", letter, receiver.URL)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("no dead letter stored")
+	}
+	if err := notifier.Close(context.Background()); err != nil {
+		t.Errorf("Close: %v", err)
+	}
+}
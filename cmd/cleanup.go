@@ -0,0 +1,29 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"log"
+
+	"pingpong/adapters/mysql"
+	"pingpong/cmd/internal/config"
+)
+
+func runCleanup(args []string) {
+	fs := flag.NewFlagSet("cleanup", flag.ExitOnError)
+	cfg, err := config.Load(fs, args, config.Flags{"mysql.dsn": "dsn"})
+	if err != nil {
+		log.Fatalf("❌ Invalid configuration: %v", err)
+	}
+
+	repo, err := mysql.NewMySQLRepository(cfg.MySQL.DSN)
+	if err != nil {
+		log.Fatalf("❌ Database connection issue: %v", err)
+	}
+
+	deleted, err := repo.DeletePlaceholderMatches(context.Background())
+	if err != nil {
+		log.Fatalf("❌ Cleanup failed: %v", err)
+	}
+	log.Printf("🧹 Deleted %d placeholder matches left by the old database test", deleted)
+}
@@ -0,0 +1,527 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"pingpong/adapters/export"
+	grpcAdapter "pingpong/adapters/grpc"
+	"pingpong/proto"
+)
+
+func (e *env) context() (context.Context, context.CancelFunc) {
+	return context.WithTimeout(context.Background(), e.opts.timeout)
+}
+
+func runNewMatch(e *env, args []string) error {
+	var watch bool
+	if _, err := e.parse("new-match", args, func(fs *flag.FlagSet) {
+		fs.BoolVar(&watch, "watch", false, "follow the match until it finishes")
+	}); err != nil {
+		return err
+	}
+	client, err := e.dial()
+	if err != nil {
+		return err
+	}
+
+	// Subscribe before starting so the MATCH_STARTED update is not missed.
+	var stream proto.PlayerService_WatchMatchClient
+	if watch {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		if stream, err = grpcAdapter.WatchMatch(ctx, client, 0); err != nil {
+			return err
+		}
+		// The server sends the headers once it has subscribed.
+		if _, err := stream.Header(); err != nil {
+			return err
+		}
+	}
+
+	ctx, cancel := e.context()
+	defer cancel()
+	res, err := grpcAdapter.StartNewMatch(ctx, client)
+	if err != nil {
+		return err
+	}
+	if stream == nil {
+		return e.print(res, func(w io.Writer) {
+			fmt.Fprintln(w, res.Message)
+		})
+	}
+	return e.followStarted(stream)
+}
+
+func runWatch(e *env, args []string) error {
+	fs, err := e.parse("watch", args, nil)
+	if err != nil {
+		return err
+	}
+	var id int
+	switch fs.NArg() {
+	case 0:
+	case 1:
+		if id, err = strconv.Atoi(fs.Arg(0)); err != nil {
+			return usagef("invalid match ID %q", fs.Arg(0))
+		}
+	default:
+		return usagef("usage: pingpong-cli watch [match-id]")
+	}
+
+	client, err := e.dial()
+	if err != nil {
+		return err
+	}
+	stream, err := grpcAdapter.WatchMatch(context.Background(), client, int32(id))
+	if err != nil {
+		return err
+	}
+	return e.follow(stream, id != 0)
+}
+
+// follow prints updates as they arrive. With untilFinished it returns after
+// the first MATCH_FINISHED update.
+func (e *env) follow(stream proto.PlayerService_WatchMatchClient, untilFinished bool) error {
+	for {
+		update, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if err := e.print(update, func(w io.Writer) { fmt.Fprintln(w, formatUpdate(update)) }); err != nil {
+			return err
+		}
+		if untilFinished && update.Kind == proto.MatchUpdate_MATCH_FINISHED {
+			return nil
+		}
+	}
+}
+
+// followStarted prints the updates of the first match to start on stream,
+// which is the one just started as no other can start while it is in play,
+// and returns once it finishes. Matches are told apart by number, as those
+// that could not be stored have no ID.
+func (e *env) followStarted(stream proto.PlayerService_WatchMatchClient) error {
+	var number int32
+	for {
+		update, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if number == 0 && update.Kind == proto.MatchUpdate_MATCH_STARTED {
+			number = update.MatchNumber
+		}
+		if number == 0 || update.MatchNumber != number {
+			continue
+		}
+		if err := e.print(update, func(w io.Writer) { fmt.Fprintln(w, formatUpdate(update)) }); err != nil {
+			return err
+		}
+		if update.Kind == proto.MatchUpdate_MATCH_FINISHED {
+			return nil
+		}
+	}
+}
+
+func runGet(e *env, args []string) error {
+	fs, err := e.parse("get", args, nil)
+	if err != nil {
+		return err
+	}
+	if fs.NArg() > 1 {
+		return usagef("usage: pingpong-cli get [match-id]")
+	}
+	client, err := e.dial()
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := e.context()
+	defer cancel()
+	var match *proto.Match
+	if fs.NArg() == 0 {
+		match, err = grpcAdapter.GetMatch(ctx, client)
+	} else {
+		id, convErr := strconv.Atoi(fs.Arg(0))
+		if convErr != nil {
+			return usagef("invalid match ID %q", fs.Arg(0))
+		}
+		match, err = grpcAdapter.GetMatchByID(ctx, client, int32(id))
+	}
+	if err != nil {
+		return err
+	}
+	return e.print(match, func(w io.Writer) { writeMatch(w, match) })
+}
+
+func runList(e *env, args []string) error {
+	var from, to, player string
+	var limit int
+	if _, err := e.parse("list", args, func(fs *flag.FlagSet) {
+		fs.StringVar(&from, "from", "", "only matches started at or after this time (RFC3339 or YYYY-MM-DD)")
+		fs.StringVar(&to, "to", "", "only matches started before this time (RFC3339 or YYYY-MM-DD)")
+		fs.StringVar(&player, "player", "", "only matches in which this player hit the ball")
+		fs.IntVar(&limit, "limit", 0, "most recent matches to show, 0 for the server default")
+	}); err != nil {
+		return err
+	}
+
+	req := &proto.ListMatchesRequest{Player: player, Limit: int32(limit)}
+	var err error
+	if req.From, err = parseTime(from); err != nil {
+		return usagef("invalid --from: %v", err)
+	}
+	if req.To, err = parseTime(to); err != nil {
+		return usagef("invalid --to: %v", err)
+	}
+
+	client, err := e.dial()
+	if err != nil {
+		return err
+	}
+	ctx, cancel := e.context()
+	defer cancel()
+	res, err := grpcAdapter.ListMatches(ctx, client, req)
+	if err != nil {
+		return err
+	}
+	return e.print(res, func(w io.Writer) { writeMatchList(w, res.Matches) })
+}
+
+func runStats(e *env, args []string) error {
+	var matchID int
+	fs, err := e.parse("stats", args, func(fs *flag.FlagSet) {
+		fs.IntVar(&matchID, "match", 0, "show statistics for this match instead of a player")
+	})
+	if err != nil {
+		return err
+	}
+	if (matchID == 0) == (fs.NArg() == 0) || fs.NArg() > 1 {
+		return usagef("usage: pingpong-cli stats <player> | stats --match <match-id>")
+	}
+	client, err := e.dial()
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := e.context()
+	defer cancel()
+	if matchID != 0 {
+		stats, err := grpcAdapter.GetMatchStats(ctx, client, int32(matchID))
+		if err != nil {
+			return err
+		}
+		return e.print(stats, func(w io.Writer) { writeMatchStats(w, stats) })
+	}
+
+	summary, err := grpcAdapter.GetPlayerStats(ctx, client, fs.Arg(0))
+	if err != nil {
+		return err
+	}
+	return e.print(summary, func(w io.Writer) { writePlayerSummary(w, summary) })
+}
+
+func runExport(e *env, args []string) error {
+	var formatFlag, from, to, player, out string
+	if _, err := e.parse("export", args, func(fs *flag.FlagSet) {
+		fs.StringVar(&formatFlag, "format", string(export.FormatJSONL), "export format: jsonl, csv or parquet")
+		fs.StringVar(&from, "from", "", "only matches started at or after this time (RFC3339 or YYYY-MM-DD)")
+		fs.StringVar(&to, "to", "", "only matches started before this time (RFC3339 or YYYY-MM-DD)")
+		fs.StringVar(&player, "player", "", "only matches in which this player hit the ball")
+		fs.StringVar(&out, "o", "-", "output file, - for stdout")
+	}); err != nil {
+		return err
+	}
+
+	format, err := export.ParseFormat(formatFlag)
+	if err != nil {
+		return usagef("%v", err)
+	}
+	req := &proto.ExportMatchesRequest{
+		Format: grpcAdapter.ExportFormatToProto(format),
+		Player: player,
+	}
+	if req.From, err = parseTime(from); err != nil {
+		return usagef("invalid --from: %v", err)
+	}
+	if req.To, err = parseTime(to); err != nil {
+		return usagef("invalid --to: %v", err)
+	}
+
+	client, err := e.dial()
+	if err != nil {
+		return err
+	}
+	stream, err := grpcAdapter.ExportMatches(context.Background(), client, req)
+	if err != nil {
+		return err
+	}
+
+	w := e.stdout
+	if out != "-" {
+		f, err := os.Create(out)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		w = f
+	}
+	for {
+		chunk, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if _, err := w.Write(chunk.Data); err != nil {
+			return err
+		}
+	}
+}
+
+func runHealth(e *env, args []string) error {
+	var service, tableAddr string
+	if _, err := e.parse("health", args, func(fs *flag.FlagSet) {
+		fs.StringVar(&service, "service", "", "check only this service, e.g. pingpong.database")
+		fs.StringVar(&tableAddr, "table-addr", "", "also check the Table service at this address")
+	}); err != nil {
+		return err
+	}
+
+	targets := []healthTarget{{addr: e.opts.addr, services: []string{
+		"",
+		proto.PlayerService_ServiceDesc.ServiceName,
+		"pingpong.database",
+		"pingpong.database.tables",
+		"pingpong.table",
+	}}}
+	if tableAddr != "" {
+		targets = append(targets, healthTarget{addr: tableAddr, services: []string{
+			proto.TableService_ServiceDesc.ServiceName,
+			"pingpong.player",
+		}})
+	}
+	if service != "" {
+		for i := range targets {
+			targets[i].services = []string{service}
+		}
+		targets = targets[:1]
+	}
+
+	if _, err := e.dial(); err != nil {
+		return err
+	}
+	results := []healthResult{}
+	var checkErr error
+	for _, target := range targets {
+		conn := e.conn
+		if target.addr != e.opts.addr {
+			tableEnv := &env{opts: &globalOptions{addr: target.addr}}
+			if _, err := tableEnv.dial(); err != nil {
+				return err
+			}
+			defer tableEnv.close()
+			conn = tableEnv.conn
+		}
+		for _, name := range target.services {
+			ctx, cancel := e.context()
+			res, err := grpcAdapter.CheckHealth(ctx, conn, name)
+			cancel()
+			result := healthResult{Addr: target.addr, Service: name, Status: healthpb.HealthCheckResponse_SERVICE_UNKNOWN.String()}
+			if err != nil {
+				result.Error = err.Error()
+				if checkErr == nil {
+					checkErr = err
+				}
+			} else {
+				result.Status = res.Status.String()
+			}
+			results = append(results, result)
+		}
+	}
+
+	if err := e.printJSON(results, func(w io.Writer) { writeHealth(w, results) }); err != nil {
+		return err
+	}
+	if checkErr != nil {
+		return checkErr
+	}
+	for _, r := range results {
+		if r.Status != healthpb.HealthCheckResponse_SERVING.String() {
+			return errUnhealthy
+		}
+	}
+	return nil
+}
+
+func runSchedule(e *env, args []string) error {
+	const usage = "usage: pingpong-cli schedule list | create --name N --cron SPEC [--matches N] | delete <id>"
+	if len(args) == 0 {
+		return usagef(usage)
+	}
+	var name, spec string
+	var matches int
+	fs, err := e.parse("schedule "+args[0], args[1:], func(fs *flag.FlagSet) {
+		if args[0] == "create" {
+			fs.StringVar(&name, "name", "", "name of the schedule")
+			fs.StringVar(&spec, "cron", "", `cron expression, e.g. "0 2 * * *" or "@every 1h"`)
+			fs.IntVar(&matches, "matches", 1, "matches to play back to back on each run")
+		}
+	})
+	if err != nil {
+		return err
+	}
+
+	var id int
+	switch {
+	case args[0] == "list" && fs.NArg() == 0:
+	case args[0] == "create" && fs.NArg() == 0:
+		if name == "" || spec == "" {
+			return usagef("--name and --cron are required")
+		}
+	case args[0] == "delete" && fs.NArg() == 1:
+		if id, err = strconv.Atoi(fs.Arg(0)); err != nil {
+			return usagef("invalid schedule ID %q", fs.Arg(0))
+		}
+	default:
+		return usagef(usage)
+	}
+
+	client, err := e.dial()
+	if err != nil {
+		return err
+	}
+	ctx, cancel := e.context()
+	defer cancel()
+	switch args[0] {
+	case "create":
+		schedule, err := grpcAdapter.CreateSchedule(ctx, client, &proto.CreateScheduleRequest{
+			Name:    name,
+			Cron:    spec,
+			Matches: int32(matches),
+		})
+		if err != nil {
+			return err
+		}
+		return e.print(schedule, func(w io.Writer) { writeSchedules(w, []*proto.Schedule{schedule}) })
+	case "delete":
+		if err := grpcAdapter.DeleteSchedule(ctx, client, int32(id)); err != nil {
+			return err
+		}
+		return e.printJSON(map[string]int{"deleted": id}, func(w io.Writer) {
+			fmt.Fprintf(w, "Schedule %d deleted\n", id)
+		})
+	default:
+		res, err := grpcAdapter.ListSchedules(ctx, client)
+		if err != nil {
+			return err
+		}
+		return e.print(res, func(w io.Writer) { writeSchedules(w, res.Schedules) })
+	}
+}
+
+func runRules(e *env, args []string) error {
+	var turnLimit int
+	var ranges [3]string
+	fs, err := e.parse("rules", args, func(fs *flag.FlagSet) {
+		fs.IntVar(&turnLimit, "turn-limit", 0, "turns Player B plays before the harder hit wins")
+		fs.StringVar(&ranges[0], "serve-power", "", "power range of the Table's serve, e.g. 70-99")
+		fs.StringVar(&ranges[1], "return-percent-a", "", "percentage range of the received power Player A returns")
+		fs.StringVar(&ranges[2], "return-power-b", "", "power range of Player B's returns")
+	})
+	if err != nil {
+		return err
+	}
+	if fs.NArg() > 0 {
+		return usagef("usage: pingpong-cli rules [flags]")
+	}
+	client, err := e.dial()
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := e.context()
+	defer cancel()
+	rules, err := grpcAdapter.GetGameRules(ctx, client)
+	if err != nil {
+		return err
+	}
+
+	changed := false
+	fs.Visit(func(f *flag.Flag) { changed = true })
+	if changed {
+		if turnLimit != 0 {
+			rules.TurnLimit = int32(turnLimit)
+		}
+		for i, target := range []**proto.PowerRange{&rules.ServePower, &rules.ReturnPercentA, &rules.ReturnPowerB} {
+			if ranges[i] == "" {
+				continue
+			}
+			if *target, err = parsePowerRange(ranges[i]); err != nil {
+				return usagef("invalid range %q: %v", ranges[i], err)
+			}
+		}
+		if rules, err = grpcAdapter.SetGameRules(ctx, client, rules); err != nil {
+			return err
+		}
+	}
+	return e.print(rules, func(w io.Writer) { writeGameRules(w, rules) })
+}
+
+// parsePowerRange parses MIN-MAX.
+func parsePowerRange(value string) (*proto.PowerRange, error) {
+	minText, maxText, ok := strings.Cut(value, "-")
+	if !ok {
+		return nil, errors.New("expected MIN-MAX")
+	}
+	lo, err := strconv.Atoi(minText)
+	if err != nil {
+		return nil, err
+	}
+	hi, err := strconv.Atoi(maxText)
+	if err != nil {
+		return nil, err
+	}
+	return &proto.PowerRange{Min: int32(lo), Max: int32(hi)}, nil
+}
+
+type healthTarget struct {
+	addr     string
+	services []string
+}
+
+type healthResult struct {
+	Addr    string `json:"addr"`
+	Service string `json:"service"`
+	Status  string `json:"status"`
+	Error   string `json:"error,omitempty"`
+}
+
+func parseTime(value string) (*timestamppb.Timestamp, error) {
+	if value == "" {
+		return nil, nil
+	}
+	for _, layout := range []string{time.RFC3339, time.DateOnly} {
+		if t, err := time.ParseInLocation(layout, value, time.Local); err == nil {
+			return timestamppb.New(t), nil
+		}
+	}
+	return nil, errors.New("expected RFC3339 or YYYY-MM-DD, got " + value)
+}
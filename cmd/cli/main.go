@@ -0,0 +1,245 @@
+// Command pingpong-cli talks to a running Player service over gRPC.
+//
+//	pingpong-cli [--addr ADDR] [--output table|json] <command> [flags] [args]
+//
+// Commands: new-match, watch, get, list, stats, export, health. Global flags
+// may also be given after the command name.
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"time"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+
+	grpcAdapter "pingpong/adapters/grpc"
+	"pingpong/cmd/internal/config"
+	"pingpong/proto"
+)
+
+// Exit codes, so scripts can tell failures apart without parsing output.
+const (
+	exitOK          = 0
+	exitError       = 1
+	exitUsage       = 2
+	exitNotFound    = 3
+	exitUnavailable = 4
+	exitInvalid     = 5
+	exitUnhealthy   = 6
+)
+
+const (
+	outputTable = "table"
+	outputJSON  = "json"
+
+	addrEnv = "PINGPONG_ADDR"
+)
+
+type globalOptions struct {
+	addr    string
+	output  string
+	timeout time.Duration
+	verbose bool
+}
+
+// register adds the global flags to fs, defaulting to their current values
+// so a command's own flag set keeps what was given before the command name.
+func (g *globalOptions) register(fs *flag.FlagSet) {
+	fs.StringVar(&g.addr, "addr", g.addr, "Player service address (default $"+addrEnv+" or localhost:"+config.DefaultPlayerPort+")")
+	fs.StringVar(&g.output, "output", g.output, "output format: table or json")
+	fs.DurationVar(&g.timeout, "timeout", g.timeout, "timeout for each request")
+	fs.BoolVar(&g.verbose, "verbose", g.verbose, "log requests to stderr")
+}
+
+type command struct {
+	name    string
+	usage   string
+	summary string
+	run     func(env *env, args []string) error
+}
+
+var commands = []command{
+	{"new-match", "new-match [--watch]", "start a new match", runNewMatch},
+	{"watch", "watch [match-id]", "follow live updates of one match, or of every match", runWatch},
+	{"get", "get [match-id]", "show a match, the latest one when no ID is given", runGet},
+	{"list", "list [--from T] [--to T] [--player P] [--limit N]", "list recent matches", runList},
+	{"stats", "stats <player> | stats --match <match-id>", "show player or match statistics", runStats},
+	{"export", "export [--format F] [--from T] [--to T] [--player P] [-o FILE]", "export matches as jsonl, csv or parquet", runExport},
+	{"health", "health [--service S] [--table-addr ADDR]", "check the health of the services", runHealth},
+	{"schedule", "schedule list | create --name N --cron SPEC [--matches N] | delete <id>", "manage scheduled runs of matches", runSchedule},
+	{"rules", "rules [--turn-limit N] [--serve-power MIN-MAX] [--return-percent-a MIN-MAX] [--return-power-b MIN-MAX]", "show the game rules, or change them from the next match on", runRules},
+}
+
+// env is what every command needs: the parsed global options, a lazily
+// opened connection and where to write results.
+type env struct {
+	opts   *globalOptions
+	conn   *grpc.ClientConn
+	client proto.PlayerServiceClient
+	stdout io.Writer
+}
+
+func (e *env) dial() (proto.PlayerServiceClient, error) {
+	if e.client != nil {
+		return e.client, nil
+	}
+	conn, err := grpc.NewClient(e.opts.addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, err
+	}
+	e.conn = conn
+	e.client = grpcAdapter.NewPlayerClientFromConn(conn)
+	return e.client, nil
+}
+
+func (e *env) close() {
+	if e.conn != nil {
+		e.conn.Close()
+	}
+}
+
+// usageError marks errors caused by how the CLI was invoked.
+type usageError struct{ msg string }
+
+func (e usageError) Error() string { return e.msg }
+
+func usagef(format string, args ...any) error {
+	return usageError{msg: fmt.Sprintf(format, args...)}
+}
+
+// errUnhealthy is returned by health when a service is not serving.
+var errUnhealthy = errors.New("one or more services are not serving")
+
+func main() {
+	os.Exit(run(os.Args[1:]))
+}
+
+func run(args []string) int {
+	opts := &globalOptions{
+		addr:    envOr(addrEnv, "localhost:"+config.DefaultPlayerPort),
+		output:  outputTable,
+		timeout: 10 * time.Second,
+	}
+
+	fs := flag.NewFlagSet("pingpong-cli", flag.ContinueOnError)
+	opts.register(fs)
+	fs.Usage = func() { printUsage(fs.Output()) }
+	if err := fs.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return exitOK
+		}
+		return exitUsage
+	}
+	if fs.NArg() == 0 {
+		printUsage(os.Stderr)
+		return exitUsage
+	}
+
+	name := fs.Arg(0)
+	for _, cmd := range commands {
+		if cmd.name != name {
+			continue
+		}
+		e := &env{opts: opts, stdout: os.Stdout}
+		defer e.close()
+		return exitCode(cmd.run(e, fs.Args()[1:]))
+	}
+
+	fmt.Fprintf(os.Stderr, "pingpong-cli: unknown command %q\n\n", name)
+	printUsage(os.Stderr)
+	return exitUsage
+}
+
+// parse reads a command's flags, which include the global ones, then applies
+// the output and logging options.
+func (e *env) parse(cmd string, args []string, define func(fs *flag.FlagSet)) (*flag.FlagSet, error) {
+	fs := flag.NewFlagSet("pingpong-cli "+cmd, flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+	e.opts.register(fs)
+	if define != nil {
+		define(fs)
+	}
+	if err := fs.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			fs.SetOutput(os.Stderr)
+			fs.PrintDefaults()
+		}
+		return nil, usagef("%s: %v", cmd, err)
+	}
+
+	if e.opts.output != outputTable && e.opts.output != outputJSON {
+		return nil, usagef("unknown output format %q (expected %q or %q)", e.opts.output, outputTable, outputJSON)
+	}
+	if !e.opts.verbose {
+		log.SetOutput(io.Discard)
+	}
+	return fs, nil
+}
+
+func printUsage(w io.Writer) {
+	fmt.Fprintln(w, "Usage: pingpong-cli [--addr ADDR] [--output table|json] [--timeout D] <command> [flags] [args]")
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "Commands:")
+	for _, cmd := range commands {
+		fmt.Fprintf(w, "  %-72s %s\n", cmd.usage, cmd.summary)
+	}
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "Exit codes: 0 ok, 1 error, 2 usage, 3 not found, 4 unavailable, 5 invalid argument, 6 unhealthy")
+}
+
+// exitCode reports err on stderr and picks the exit code for it.
+func exitCode(err error) int {
+	if err == nil {
+		return exitOK
+	}
+
+	var usage usageError
+	if errors.As(err, &usage) {
+		fmt.Fprintf(os.Stderr, "pingpong-cli: %v\n", err)
+		return exitUsage
+	}
+	if errors.Is(err, errUnhealthy) {
+		fmt.Fprintf(os.Stderr, "pingpong-cli: %v\n", err)
+		return exitUnhealthy
+	}
+
+	st, ok := status.FromError(err)
+	if !ok {
+		fmt.Fprintf(os.Stderr, "pingpong-cli: %v\n", err)
+		return exitError
+	}
+	fmt.Fprintf(os.Stderr, "pingpong-cli: %s\n", st.Message())
+	for _, detail := range st.Details() {
+		if badRequest, ok := detail.(*errdetails.BadRequest); ok {
+			for _, v := range badRequest.FieldViolations {
+				fmt.Fprintf(os.Stderr, "  %s: %s\n", v.Field, v.Description)
+			}
+		}
+	}
+	switch st.Code() {
+	case codes.NotFound:
+		return exitNotFound
+	case codes.Unavailable, codes.DeadlineExceeded:
+		return exitUnavailable
+	case codes.InvalidArgument, codes.OutOfRange, codes.FailedPrecondition:
+		return exitInvalid
+	default:
+		return exitError
+	}
+}
+
+func envOr(key string, fallback string) string {
+	if value := os.Getenv(key); value != "" {
+		return value
+	}
+	return fallback
+}
@@ -0,0 +1,164 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"text/tabwriter"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	protobuf "google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"pingpong/proto"
+)
+
+const timeLayout = "2006-01-02 15:04:05"
+
+// print writes msg as a line of JSON with --output json, otherwise through
+// table.
+func (e *env) print(msg protobuf.Message, table func(w io.Writer)) error {
+	if e.opts.output == outputJSON {
+		data, err := protojson.Marshal(msg)
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprintln(e.stdout, string(data))
+		return err
+	}
+	return e.printTable(table)
+}
+
+// printJSON is print for values that are not protobuf messages.
+func (e *env) printJSON(v any, table func(w io.Writer)) error {
+	if e.opts.output == outputJSON {
+		return json.NewEncoder(e.stdout).Encode(v)
+	}
+	return e.printTable(table)
+}
+
+func (e *env) printTable(table func(w io.Writer)) error {
+	tw := tabwriter.NewWriter(e.stdout, 0, 4, 2, ' ', 0)
+	table(tw)
+	return tw.Flush()
+}
+
+func writeMatch(w io.Writer, m *proto.Match) {
+	fmt.Fprintf(w, "ID:\t%d\n", m.Id)
+	fmt.Fprintf(w, "Match:\t#%d\n", m.MatchNumber)
+	fmt.Fprintf(w, "Started:\t%s\n", formatTime(m.StartTime))
+	fmt.Fprintf(w, "Ended:\t%s\n", formatTime(m.EndTime))
+	fmt.Fprintf(w, "Winner:\t%s\n", orDash(m.Winner))
+	fmt.Fprintf(w, "Turns:\t%d\n", len(m.Turns))
+	if r := m.GameRules; r != nil {
+		fmt.Fprintf(w, "Rules:\t%s\n", formatGameRules(r))
+	}
+	if len(m.Turns) == 0 {
+		return
+	}
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "TURN\tTIME\tPLAYER\tPOWER")
+	for _, t := range m.Turns {
+		fmt.Fprintf(w, "%d\t%s\t%s\t%d\n", t.TurnNumber, t.Time.AsTime().Local().Format(timeLayout), t.Player, t.BallPower)
+	}
+}
+
+func writeSchedules(w io.Writer, schedules []*proto.Schedule) {
+	fmt.Fprintln(w, "ID\tNAME\tCRON\tMATCHES\tCREATED")
+	for _, s := range schedules {
+		fmt.Fprintf(w, "%d\t%s\t%s\t%d\t%s\n", s.Id, s.Name, s.Cron, s.Matches, formatTime(s.CreateTime))
+	}
+}
+
+func writeGameRules(w io.Writer, r *proto.GameRules) {
+	fmt.Fprintf(w, "Turn limit:\t%d\n", r.TurnLimit)
+	fmt.Fprintf(w, "Serve power:\t%s\n", formatPowerRange(r.ServePower))
+	fmt.Fprintf(w, "Player A returns:\t%s%%\n", formatPowerRange(r.ReturnPercentA))
+	fmt.Fprintf(w, "Player B power:\t%s\n", formatPowerRange(r.ReturnPowerB))
+}
+
+func formatGameRules(r *proto.GameRules) string {
+	return fmt.Sprintf("turn limit %d, serve %s, A returns %s%%, B %s",
+		r.TurnLimit, formatPowerRange(r.ServePower), formatPowerRange(r.ReturnPercentA), formatPowerRange(r.ReturnPowerB))
+}
+
+func formatPowerRange(r *proto.PowerRange) string {
+	return fmt.Sprintf("%d-%d", r.GetMin(), r.GetMax())
+}
+
+func writeMatchList(w io.Writer, matches []*proto.Match) {
+	fmt.Fprintln(w, "ID\tMATCH\tSTARTED\tENDED\tTURNS\tWINNER")
+	for _, m := range matches {
+		fmt.Fprintf(w, "%d\t#%d\t%s\t%s\t%d\t%s\n",
+			m.Id, m.MatchNumber,
+			formatTime(m.StartTime),
+			formatTime(m.EndTime),
+			len(m.Turns), orDash(m.Winner))
+	}
+}
+
+func writePlayerSummary(w io.Writer, s *proto.PlayerSummary) {
+	fmt.Fprintln(w, "PLAYER\tMATCHES\tWINS\tLOSSES\tDRAWS\tHITS\tAVG POWER")
+	fmt.Fprintf(w, "%s\t%d\t%d\t%d\t%d\t%d\t%.1f\n",
+		s.PlayerId, s.Matches, s.Wins, s.Losses, s.Draws, s.Hits, s.AverageBallPower)
+}
+
+func writeMatchStats(w io.Writer, s *proto.MatchStats) {
+	fmt.Fprintf(w, "Match ID:\t%d\n", s.MatchId)
+	fmt.Fprintf(w, "Rally:\t%d\n", s.Rally)
+	fmt.Fprintf(w, "Duration:\t%s\n", s.Duration.AsDuration())
+	fmt.Fprintf(w, "Pauses:\t%d\n", s.Pauses)
+	fmt.Fprintf(w, "Winner:\t%s\n", orDash(s.Winner))
+	fmt.Fprintf(w, "Finished by:\t%s\n", orDash(s.FinishedBy))
+	fmt.Fprintln(w)
+
+	players := make([]string, 0, len(s.PlayerStats))
+	for name := range s.PlayerStats {
+		players = append(players, name)
+	}
+	sort.Strings(players)
+	fmt.Fprintln(w, "PLAYER\tHITS\tAVG RECEIVE\tAVG RETURN\tMAX RETURN\tPOINTS")
+	for _, name := range players {
+		p := s.PlayerStats[name]
+		fmt.Fprintf(w, "%s\t%d\t%.1f\t%.1f\t%d\t%d\n", name, p.Hits, p.AverageReceive, p.AverageReturn, p.MaxReturn, p.Points)
+	}
+}
+
+func writeHealth(w io.Writer, results []healthResult) {
+	fmt.Fprintln(w, "ADDRESS\tSERVICE\tSTATUS\tERROR")
+	for _, r := range results {
+		service := r.Service
+		if service == "" {
+			service = "(overall)"
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", r.Addr, service, r.Status, r.Error)
+	}
+}
+
+func formatUpdate(u *proto.MatchUpdate) string {
+	switch u.Kind {
+	case proto.MatchUpdate_MATCH_STARTED:
+		return fmt.Sprintf("🏓 Match #%d (ID %d) started at %s", u.MatchNumber, u.MatchId, u.Time.AsTime().Local().Format(timeLayout))
+	case proto.MatchUpdate_TURN:
+		return fmt.Sprintf("   #%-3d Player %s receives %3d", u.Turn.TurnNumber, u.Turn.Player, u.Turn.BallPower)
+	case proto.MatchUpdate_MATCH_FINISHED:
+		return fmt.Sprintf("🏁 Match #%d (ID %d) finished, winner: %s", u.MatchNumber, u.MatchId, orDash(u.Winner))
+	default:
+		return u.Kind.String()
+	}
+}
+
+func formatTime(ts *timestamppb.Timestamp) string {
+	if ts == nil {
+		return "-"
+	}
+	return ts.AsTime().Local().Format(timeLayout)
+}
+
+func orDash(s string) string {
+	if s == "" {
+		return "-"
+	}
+	return s
+}
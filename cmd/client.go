@@ -0,0 +1,18 @@
+package main
+
+import (
+	"log"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	"pingpong/proto"
+)
+
+func dialPlayerService(addr string) (*grpc.ClientConn, proto.PlayerServiceClient) {
+	conn, err := grpc.NewClient(addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		log.Fatalf("❌ Failed to connect to Player service: %v", err)
+	}
+	return conn, proto.NewPlayerServiceClient(conn)
+}
@@ -0,0 +1,29 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"os"
+
+	"pingpong/cmd/internal/config"
+)
+
+// runConfig handles "pingpong config print", which takes the same -config
+// file, environment and flags as running the services and prints the
+// settings they would start with.
+func runConfig(args []string) {
+	if len(args) == 0 || args[0] != "print" {
+		fmt.Fprintln(os.Stderr, "Usage: pingpong config print [-config FILE] [flags]")
+		os.Exit(2)
+	}
+
+	fs := flag.NewFlagSet("config print", flag.ExitOnError)
+	cfg, err := loadConfig(fs, args[1:])
+	if err != nil {
+		log.Fatalf("❌ Invalid configuration: %v", err)
+	}
+	if err := config.Print(os.Stdout, cfg); err != nil {
+		log.Fatalf("❌ Failed to print configuration: %v", err)
+	}
+}
@@ -0,0 +1,88 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"io"
+	"log"
+	"os"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"pingpong/adapters/export"
+	grpcAdapter "pingpong/adapters/grpc"
+	"pingpong/cmd/internal/config"
+	"pingpong/proto"
+)
+
+func runExport(args []string) {
+	fs := flag.NewFlagSet("export", flag.ExitOnError)
+	addr := fs.String("addr", "localhost:"+config.DefaultPlayerPort, "Player service address")
+	formatFlag := fs.String("format", string(export.FormatJSONL), "output format: jsonl, csv or parquet")
+	from := fs.String("from", "", "only matches started at or after this time (RFC3339 or YYYY-MM-DD)")
+	to := fs.String("to", "", "only matches started before this time (RFC3339 or YYYY-MM-DD)")
+	player := fs.String("player", "", "only matches in which this player hit the ball")
+	out := fs.String("o", "-", "output file, - for stdout")
+	fs.Parse(args)
+
+	format, err := export.ParseFormat(*formatFlag)
+	if err != nil {
+		log.Fatalf("❌ %v", err)
+	}
+
+	req := &proto.ExportMatchesRequest{
+		Format: grpcAdapter.ExportFormatToProto(format),
+		Player: *player,
+	}
+	if req.From, err = parseTimeFlag(*from); err != nil {
+		log.Fatalf("❌ Invalid -from: %v", err)
+	}
+	if req.To, err = parseTimeFlag(*to); err != nil {
+		log.Fatalf("❌ Invalid -to: %v", err)
+	}
+
+	conn, playerClient := dialPlayerService(*addr)
+	defer conn.Close()
+
+	var w io.Writer = os.Stdout
+	if *out != "-" {
+		f, err := os.Create(*out)
+		if err != nil {
+			log.Fatalf("❌ Failed to create output file: %v", err)
+		}
+		defer f.Close()
+		w = f
+	}
+
+	stream, err := playerClient.ExportMatches(context.Background(), req)
+	if err != nil {
+		log.Fatalf("❌ Export failed: %v", err)
+	}
+
+	for {
+		chunk, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			log.Fatalf("❌ Export failed: %v", err)
+		}
+		if _, err := w.Write(chunk.Data); err != nil {
+			log.Fatalf("❌ Failed to write export: %v", err)
+		}
+	}
+}
+
+func parseTimeFlag(value string) (*timestamppb.Timestamp, error) {
+	if value == "" {
+		return nil, nil
+	}
+	for _, layout := range []string{time.RFC3339, time.DateOnly} {
+		if t, err := time.ParseInLocation(layout, value, time.Local); err == nil {
+			return timestamppb.New(t), nil
+		}
+	}
+	return nil, errors.New("expected RFC3339 or YYYY-MM-DD, got " + value)
+}
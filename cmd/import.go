@@ -0,0 +1,74 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"log"
+	"os"
+
+	"pingpong/adapters/mysql"
+	"pingpong/adapters/turnlog"
+	"pingpong/cmd/internal/config"
+	"pingpong/domain"
+	"pingpong/service"
+)
+
+func runImport(args []string) {
+	fs := flag.NewFlagSet("import", flag.ExitOnError)
+	dryRun := fs.Bool("dry-run", false, "parse the files and report matches without saving them")
+	cfg, err := config.Load(fs, args, config.Flags{"mysql.dsn": "dsn"})
+	if err != nil {
+		log.Fatalf("❌ Invalid configuration: %v", err)
+	}
+
+	if fs.NArg() == 0 {
+		log.Fatalf("❌ Usage: pingpong import [-dsn DSN] [-dry-run] match_log.csv...")
+	}
+
+	var turns []domain.Turn
+	for _, path := range fs.Args() {
+		f, err := os.Open(path)
+		if err != nil {
+			log.Fatalf("❌ Failed to open %s: %v", path, err)
+		}
+		fileTurns, err := turnlog.ReadTurns(f)
+		f.Close()
+		if err != nil {
+			log.Fatalf("❌ Failed to read %s: %v", path, err)
+		}
+		log.Printf("📄 Read %d turns from %s", len(fileTurns), path)
+		turns = append(turns, fileTurns...)
+	}
+
+	matches := turnlog.GroupMatches(turns)
+	log.Printf("🧩 Reconstructed %d matches", len(matches))
+
+	if *dryRun {
+		for _, match := range matches {
+			log.Printf("🏓 %s: %d turns, %s → %s, winner %q",
+				match.Turns[0].RoutineID, len(match.Turns), match.StartTime, match.EndTime, match.Winner)
+		}
+		return
+	}
+
+	repo, err := mysql.NewMySQLRepository(cfg.MySQL.DSN)
+	if err != nil {
+		log.Fatalf("❌ Database connection issue: %v", err)
+	}
+	matchService := service.NewMatchService(repo, nil)
+
+	imported, skipped := 0, 0
+	for _, match := range matches {
+		saved, err := matchService.ImportMatch(context.Background(), match)
+		if err != nil {
+			log.Fatalf("❌ Failed to import %s: %v", match.Turns[0].RoutineID, err)
+		}
+		if saved {
+			imported++
+		} else {
+			skipped++
+		}
+	}
+
+	log.Printf("✅ Imported %d matches, skipped %d already stored", imported, skipped)
+}
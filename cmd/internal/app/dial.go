@@ -0,0 +1,65 @@
+// Package app wires the Player and Table services so the combined dev binary
+// and the standalone cmd/player and cmd/table binaries start them the same way.
+package app
+
+import (
+	"context"
+	"net"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	"pingpong/adapters/metrics"
+	"pingpong/adapters/tracing"
+	"pingpong/logging"
+)
+
+var logger = logging.For(logging.ComponentApp)
+
+// Dial connects to a peer service. Calls wait for the peer to become
+// reachable instead of failing, so the services can start in any order, and
+// carry the caller's trace.
+func Dial(addr string) (*grpc.ClientConn, error) {
+	return grpc.NewClient(addr, append(tracing.DialOptions(),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithDefaultCallOptions(grpc.WaitForReady(true)),
+	)...)
+}
+
+func serveGRPC(server *grpc.Server, lis net.Listener, name string) {
+	logger.Info("🏓 gRPC server starting", "service", name, "addr", lis.Addr().String())
+	if err := server.Serve(lis); err != nil {
+		logger.Error("❌ gRPC server stopped", "service", name, "err", err)
+	}
+}
+
+// stopGRPC waits for RPCs in progress until ctx is done, then cuts off the
+// rest, such as WatchMatch streams that never end on their own.
+func stopGRPC(ctx context.Context, server *grpc.Server) {
+	stopped := make(chan struct{})
+	go func() {
+		server.GracefulStop()
+		close(stopped)
+	}()
+
+	select {
+	case <-stopped:
+	case <-ctx.Done():
+		server.Stop()
+		<-stopped
+	}
+}
+
+// SignalContext is done once the process receives SIGINT or SIGTERM.
+func SignalContext() (context.Context, context.CancelFunc) {
+	return signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+}
+
+// serverOptions instrument a Player or Table gRPC server with metrics and
+// tracing.
+func serverOptions() []grpc.ServerOption {
+	return append(metrics.ServerOptions(), tracing.ServerOptions()...)
+}
@@ -0,0 +1,131 @@
+package app
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/eiannone/keyboard"
+
+	"pingpong/cmd/internal/config"
+	"pingpong/cmd/internal/tui"
+	pb "pingpong/proto"
+)
+
+// RunUI drives the Player service at playerAddr from the terminal with the
+// chosen front-end until ESC is pressed or ctx is done. With config.UINone it
+// only waits for ctx; matches are then started over RPC. config.UIAuto runs
+// the TUI when there is a terminal and is headless otherwise, e.g. under
+// systemd or in a container.
+func RunUI(ctx context.Context, ui string, playerAddr string) {
+	if ui == config.UINone {
+		runHeadless(ctx)
+		return
+	}
+
+	conn, err := Dial(playerAddr)
+	if err != nil {
+		logger.Error("❌ Failed to connect to Player service", "err", err)
+		return
+	}
+	defer conn.Close()
+	playerClient := pb.NewPlayerServiceClient(conn)
+
+	if ui == config.UITerminal || ui == config.UIAuto {
+		err := tui.Run(ctx, playerClient)
+		if err != nil && ui == config.UIAuto {
+			logger.Warn("⚠️ No terminal available", "err", err)
+			runHeadless(ctx)
+		} else if err != nil {
+			logger.Error("❌ Terminal UI failed", "err", err)
+		}
+		return
+	}
+	if err := runKeyboard(ctx, playerClient); err != nil {
+		logger.Error("❌ Keyboard UI failed", "err", err)
+	}
+}
+
+func runHeadless(ctx context.Context) {
+	logger.Info("🤖 Running headless, start matches over RPC")
+	<-ctx.Done()
+}
+
+// shotKeys pick the power of a human Player A's shot.
+var shotKeys = map[rune]pb.ShotPower{
+	'1': pb.ShotPower_SHOT_POWER_SOFT,
+	'2': pb.ShotPower_SHOT_POWER_MEDIUM,
+	'3': pb.ShotPower_SHOT_POWER_HARD,
+}
+
+// runKeyboard is the plain front-end: key presses in, log lines out.
+func runKeyboard(ctx context.Context, playerClient pb.PlayerServiceClient) error {
+	keys, err := keyboard.GetKeys(10)
+	if err != nil {
+		return fmt.Errorf("failed to open keyboard: %w", err)
+	}
+	defer keyboard.Close()
+
+	logger.Info("🎮 Press Space Bar to start a new match, F2 to test DB, P to pause or resume, 1-3 to hit as Player A, ESC to exit")
+	paused := false
+	for {
+		var event keyboard.KeyEvent
+		select {
+		case <-ctx.Done():
+			return nil
+		case event = <-keys:
+		}
+		char, key := event.Rune, event.Key
+		if event.Err != nil {
+			logger.Warn("⚠️ Error reading key", "err", event.Err)
+			continue
+		}
+
+		switch key {
+		case keyboard.KeySpace:
+			logger.Info("🏓 Space Bar pressed - Starting a new match...")
+			_, err := playerClient.StartNewMatch(context.Background(), &pb.NewMatchRequest{})
+			if err != nil {
+				logger.Error("❌ Failed to start match", "err", err)
+			} else {
+				logger.Info("✅ Match started successfully")
+			}
+
+		case keyboard.KeyF2:
+			logger.Info("🧪 F2 pressed - Testing DB...")
+			res, err := playerClient.TestDB(context.Background(), &pb.TestDBRequest{})
+			if err != nil {
+				logger.Error("❌ TestDB failed", "err", err)
+			} else {
+				logger.Info("✅ TestDB successful", "latency_ms", res.LatencyMs, "schema_version", res.SchemaVersion)
+			}
+
+		case keyboard.KeyEsc, keyboard.KeyCtrlC:
+			logger.Info("👋 ESC pressed - Exiting...")
+			return nil
+
+		default:
+			if power, ok := shotKeys[char]; ok {
+				res, err := playerClient.HitBall(context.Background(), &pb.HitBallRequest{Power: power})
+				if err != nil {
+					logger.Error("❌ Swing failed", "err", err)
+				} else {
+					logger.Info("🏓 You hit the ball back", "return_power", res.ReturnPower, "out", res.Out)
+				}
+				continue
+			}
+			if char == 'p' || char == 'P' {
+				res, err := playerClient.PauseMatch(context.Background(), &pb.PauseMatchRequest{Paused: !paused})
+				if err != nil {
+					logger.Error("❌ Pause failed", "err", err)
+				} else {
+					paused = res.Paused
+					logger.Info("⏯️ Match paused", "paused", paused)
+				}
+				continue
+			}
+			if char != 0 {
+				logger.Debug("🔘 Key pressed", "key", string(char))
+			}
+		}
+	}
+}
@@ -0,0 +1,37 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"pingpong/adapters/metrics"
+)
+
+// serveMetrics serves Prometheus metrics on /metrics at port. It returns nil
+// when port is empty.
+func serveMetrics(port, name string) *http.Server {
+	if port == "" {
+		return nil
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", metrics.Handler())
+	server := &http.Server{Addr: ":" + port, Handler: mux}
+	go func() {
+		logger.Info("📈 Metrics server starting", "service", name, "port", port)
+		if err := server.ListenAndServe(); !errors.Is(err, http.ErrServerClosed) {
+			logger.Error("❌ Failed to serve metrics", "service", name, "err", err)
+		}
+	}()
+	return server
+}
+
+func stopMetrics(ctx context.Context, server *http.Server) {
+	if server == nil {
+		return
+	}
+	if err := server.Shutdown(ctx); err != nil {
+		logger.Warn("⚠️ Metrics server did not stop cleanly", "err", err)
+	}
+}
@@ -0,0 +1,201 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+
+	"google.golang.org/grpc"
+
+	grpcAdapter "pingpong/adapters/grpc"
+	"pingpong/adapters/metrics"
+	"pingpong/adapters/mysql"
+	natsAdapter "pingpong/adapters/nats"
+	"pingpong/adapters/rest"
+	"pingpong/adapters/turnlog"
+	"pingpong/adapters/webhook"
+	"pingpong/cmd/internal/config"
+	"pingpong/ports"
+	"pingpong/service"
+)
+
+type Player struct {
+	Server       *grpcAdapter.PlayerServer
+	MatchService ports.MatchService
+
+	turnSink      ports.TurnSink
+	publisher     ports.EventPublisher
+	notifier      *webhook.Notifier
+	scheduler     *service.Scheduler
+	tableConn     *grpc.ClientConn
+	grpcServer    *grpc.Server
+	httpServer    *http.Server
+	metricsServer *http.Server
+}
+
+// StartPlayer opens the Player service's storage and outputs, serves it on
+// cfg.Player.Port (and the REST API on cfg.Player.HTTPPort and metrics on
+// cfg.Player.MetricsPort), connects it to
+// the Table service on cfg.Player.TableAddr and recovers unfinished matches.
+// A database that cannot be reached is logged rather than treated as fatal.
+func StartPlayer(cfg config.Config) (*Player, error) {
+	opts := cfg.Player
+	recoveryPolicy, err := grpcAdapter.ParseRecoveryPolicy(opts.Recovery)
+	if err != nil {
+		return nil, fmt.Errorf("invalid recovery policy: %w", err)
+	}
+
+	logger.Info("📝 Opening turn log", "spec", opts.TurnLog.Spec)
+	turnSink, err := turnlog.OpenSinks(opts.TurnLog.Spec, turnlog.Rotation{
+		MaxBytes:   opts.TurnLog.MaxBytes,
+		MaxBackups: opts.TurnLog.MaxBackups,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to open turn log: %w", err)
+	}
+	p := &Player{turnSink: turnSink}
+
+	logger.Info("🔌 Connecting to MySQL database...")
+	var store ports.MatchRepository
+	repo, dbErr := mysql.NewMySQLRepository(cfg.MySQL.DSN)
+	if dbErr != nil {
+		logger.Warn("⚠️ Database connection issue", "err", dbErr)
+		store = mysql.NewUnavailableRepository(dbErr)
+	} else {
+		store = repo
+		if err := metrics.RegisterDB(repo.DB(), "pingpong"); err != nil {
+			logger.Warn("⚠️ Failed to export database metrics", "err", err)
+		}
+	}
+	if opts.NATS.URL != "" {
+		logger.Info("📡 Connecting to NATS...", "url", opts.NATS.URL)
+		natsPublisher, err := natsAdapter.NewPublisher(opts.NATS.URL, opts.NATS.Subject)
+		if err != nil {
+			logger.Warn("⚠️ NATS connection issue, match events will not be published", "err", err)
+		} else {
+			p.publisher = natsPublisher
+		}
+	}
+	p.MatchService = service.NewMatchService(store, p.publisher)
+
+	tableConn, err := Dial(opts.TableAddr)
+	if err != nil {
+		p.close()
+		return nil, fmt.Errorf("failed to connect to Table service at %s: %w", opts.TableAddr, err)
+	}
+	p.tableConn = tableConn
+	logger.Info("🔗 Player will send balls to Table service", "addr", opts.TableAddr)
+
+	p.Server = grpcAdapter.NewPlayerServer(p.MatchService, turnSink, tableConn)
+	p.Server.Metrics = metrics.MatchMetrics{}
+	p.Server.MatchTimeout = opts.MatchTimeout
+	if err := p.Server.SetRules(cfg.Game); err != nil {
+		p.close()
+		return nil, err
+	}
+	if opts.Human {
+		p.Server.HumanShotWindow = opts.ShotWindow
+		logger.Info("🙋 Player A is played by a human", "shot_window", opts.ShotWindow)
+	}
+	if len(opts.Webhooks.URLs) > 0 {
+		webhookConfig := webhook.DefaultConfig()
+		webhookConfig.URLs = opts.Webhooks.URLs
+		webhookConfig.Secret = opts.Webhooks.Secret
+
+		var deadLetters ports.DeadLetterStore
+		if dbErr == nil {
+			deadLetters = repo
+		}
+		p.notifier, err = webhook.NewNotifier(webhookConfig, deadLetters)
+		if err != nil {
+			p.close()
+			return nil, err
+		}
+		p.Server.Notifier = p.notifier
+		logger.Info("🪝 Sending match results to webhooks", "count", len(webhookConfig.URLs))
+	}
+
+	if dbErr == nil {
+		p.scheduler = service.NewScheduler(repo, p.Server)
+		p.Server.Schedules = p.scheduler
+	}
+
+	lis, err := net.Listen("tcp", ":"+opts.Port)
+	if err != nil {
+		p.close()
+		return nil, fmt.Errorf("failed to listen on port %s: %w", opts.Port, err)
+	}
+	p.grpcServer = grpcAdapter.NewGRPCServer(p.Server, serverOptions()...)
+	go serveGRPC(p.grpcServer, lis, "Player")
+	p.metricsServer = serveMetrics(opts.MetricsPort, "Player")
+
+	if opts.HTTPPort != "" {
+		restServer := rest.NewServer(p.MatchService, p.Server)
+		restServer.AllowedOrigins = opts.AllowedOrigins
+		p.httpServer = &http.Server{Addr: ":" + opts.HTTPPort, Handler: restServer}
+		go func() {
+			logger.Info("🌐 HTTP server starting", "port", opts.HTTPPort)
+			if err := p.httpServer.ListenAndServe(); !errors.Is(err, http.ErrServerClosed) {
+				logger.Error("❌ Failed to serve HTTP", "err", err)
+			}
+		}()
+	}
+
+	if dbErr == nil {
+		logger.Info("🩹 Recovering unfinished matches...")
+		report, err := p.Server.RecoverMatches(context.Background(), recoveryPolicy)
+		if err != nil {
+			logger.Warn("⚠️ Match recovery failed", "err", err)
+		} else {
+			report.Log()
+		}
+	}
+	if p.scheduler != nil {
+		if err := p.scheduler.Start(context.Background()); err != nil {
+			logger.Warn("⚠️ Failed to load schedules", "err", err)
+		}
+	}
+	return p, nil
+}
+
+// Shutdown stops the scheduler and taking new matches, lets the one in
+// progress finish or aborts it once ctx is done, then stops the servers and flushes pending
+// webhooks, the turn log and published events.
+func (p *Player) Shutdown(ctx context.Context) {
+	logger.Info("🛑 Shutting down Player service...")
+	if p.scheduler != nil {
+		p.scheduler.Stop(ctx)
+	}
+	p.Server.Drain(ctx)
+
+	if p.httpServer != nil {
+		if err := p.httpServer.Shutdown(ctx); err != nil {
+			logger.Warn("⚠️ HTTP server did not stop cleanly", "err", err)
+		}
+	}
+	stopGRPC(ctx, p.grpcServer)
+	stopMetrics(ctx, p.metricsServer)
+
+	if p.notifier != nil {
+		if err := p.notifier.Close(ctx); err != nil {
+			logger.Warn("⚠️ Webhooks still pending at shutdown were stored as dead letters", "err", err)
+		}
+	}
+	p.close()
+	logger.Info("✅ Player service stopped")
+}
+
+// close flushes the turn log and releases the Player's connections.
+func (p *Player) close() {
+	if err := p.turnSink.Close(); err != nil {
+		logger.Warn("⚠️ Failed to close turn log", "err", err)
+	}
+	if p.publisher != nil {
+		p.publisher.Close()
+	}
+	if p.tableConn != nil {
+		p.tableConn.Close()
+	}
+}
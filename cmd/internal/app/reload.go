@@ -0,0 +1,45 @@
+package app
+
+import (
+	"context"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"pingpong/cmd/internal/config"
+	"pingpong/domain"
+)
+
+// RulesSetter is a server whose game rules can change while it runs.
+type RulesSetter interface {
+	SetRules(rules domain.GameRules) error
+}
+
+// ReloadOnHangup reloads the configuration with load each time the process
+// receives SIGHUP, until ctx is done, and hands the new game rules to
+// servers. Other settings only take effect on restart.
+func ReloadOnHangup(ctx context.Context, load func() (config.Config, error), servers ...RulesSetter) {
+	hangup := make(chan os.Signal, 1)
+	signal.Notify(hangup, syscall.SIGHUP)
+	defer signal.Stop(hangup)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-hangup:
+		}
+
+		logger.Info("🔄 SIGHUP received, reloading game rules...")
+		cfg, err := load()
+		if err != nil {
+			logger.Warn("⚠️ Keeping the current game rules, configuration is invalid", "err", err)
+			continue
+		}
+		for _, server := range servers {
+			if err := server.SetRules(cfg.Game); err != nil {
+				logger.Warn("⚠️ Failed to apply game rules", "err", err)
+			}
+		}
+	}
+}
@@ -0,0 +1,61 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+
+	"google.golang.org/grpc"
+
+	grpcAdapter "pingpong/adapters/grpc"
+	"pingpong/cmd/internal/config"
+)
+
+type Table struct {
+	Server        *grpcAdapter.TableServer
+	playerConn    *grpc.ClientConn
+	grpcServer    *grpc.Server
+	metricsServer *http.Server
+}
+
+// StartTable serves the Table service on cfg.Table.Port, and its metrics on
+// cfg.Table.MetricsPort, and points it at the Player service on
+// cfg.Table.PlayerAddr.
+func StartTable(cfg config.Config) (*Table, error) {
+	opts := cfg.Table
+	playerConn, err := Dial(opts.PlayerAddr)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to Player service at %s: %w", opts.PlayerAddr, err)
+	}
+	logger.Info("🔗 Table will send balls to Player service", "addr", opts.PlayerAddr)
+
+	t := &Table{
+		Server:     grpcAdapter.NewTableServer(playerConn),
+		playerConn: playerConn,
+	}
+	if err := t.Server.SetRules(cfg.Game); err != nil {
+		playerConn.Close()
+		return nil, err
+	}
+
+	lis, err := net.Listen("tcp", ":"+opts.Port)
+	if err != nil {
+		playerConn.Close()
+		return nil, fmt.Errorf("failed to listen on port %s: %w", opts.Port, err)
+	}
+	t.grpcServer = grpcAdapter.NewGRPCServer(t.Server, serverOptions()...)
+	go serveGRPC(t.grpcServer, lis, "Table")
+	t.metricsServer = serveMetrics(opts.MetricsPort, "Table")
+	return t, nil
+}
+
+// Shutdown stops the Table service, waiting for calls in progress until ctx
+// is done.
+func (t *Table) Shutdown(ctx context.Context) {
+	logger.Info("🛑 Shutting down Table service...")
+	stopGRPC(ctx, t.grpcServer)
+	stopMetrics(ctx, t.metricsServer)
+	t.playerConn.Close()
+	logger.Info("✅ Table service stopped")
+}
@@ -0,0 +1,30 @@
+package app
+
+import (
+	"context"
+
+	"pingpong/adapters/tracing"
+	"pingpong/cmd/internal/config"
+)
+
+// StartTracing exports the process's spans as cfg.Tracing says, naming them
+// after service. The returned function flushes the spans still buffered.
+func StartTracing(cfg config.Config, service string) (func(context.Context), error) {
+	opts := cfg.Tracing
+	shutdown, err := tracing.Setup(context.Background(), opts.Exporter, opts.Endpoint, service)
+	if err != nil {
+		return nil, err
+	}
+	switch opts.Exporter {
+	case tracing.ExporterStdout:
+		logger.Info("🔭 Writing traces to stdout", "service", service)
+	case tracing.ExporterOTLP:
+		logger.Info("🔭 Sending traces to the OTLP collector", "service", service, "endpoint", opts.Endpoint)
+	}
+
+	return func(ctx context.Context) {
+		if err := shutdown(ctx); err != nil {
+			logger.Warn("⚠️ Failed to flush traces", "err", err)
+		}
+	}, nil
+}
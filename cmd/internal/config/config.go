@@ -0,0 +1,290 @@
+// Package config is the typed configuration of the pingpong binaries. Every
+// setting has a default, and can be overridden, in increasing order of
+// precedence, by a YAML file, a PINGPONG_* environment variable and a flag.
+package config
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net"
+	"net/url"
+	"strconv"
+	"time"
+
+	mysqlDriver "github.com/go-sql-driver/mysql"
+	"gopkg.in/yaml.v3"
+
+	grpcAdapter "pingpong/adapters/grpc"
+	natsAdapter "pingpong/adapters/nats"
+	"pingpong/adapters/tracing"
+	"pingpong/domain"
+	"pingpong/logging"
+)
+
+// Front-ends selectable with ui.
+const (
+	UIAuto     = "auto"
+	UITerminal = "tui"
+	UIKeys     = "keys"
+	UINone     = "none"
+)
+
+type Config struct {
+	Player          PlayerConfig     `yaml:"player"`
+	Table           TableConfig      `yaml:"table"`
+	MySQL           MySQLConfig      `yaml:"mysql"`
+	Game            domain.GameRules `yaml:"game"`
+	Tracing         TracingConfig    `yaml:"tracing"`
+	Log             LogConfig        `yaml:"log"`
+	UI              string           `yaml:"ui"`
+	ShutdownTimeout time.Duration    `yaml:"shutdown_timeout"`
+}
+
+type PlayerConfig struct {
+	Port      string `yaml:"port"`
+	TableAddr string `yaml:"table_addr"`
+	// HTTPPort serves the REST API; empty disables it.
+	HTTPPort string `yaml:"http_port"`
+	// AllowedOrigins may open the live scoreboard feed besides the REST
+	// API's own pages.
+	AllowedOrigins []string `yaml:"allowed_origins"`
+	// MetricsPort serves Prometheus metrics on /metrics; empty disables it.
+	MetricsPort string        `yaml:"metrics_port"`
+	Recovery    string        `yaml:"recovery"`
+	TurnLog     TurnLogConfig `yaml:"turn_log"`
+	NATS        NATSConfig    `yaml:"nats"`
+	Webhooks    WebhookConfig `yaml:"webhooks"`
+	Human       bool          `yaml:"human"`
+	ShotWindow  time.Duration `yaml:"shot_window"`
+	// MatchTimeout aborts matches still in play after this long.
+	MatchTimeout time.Duration `yaml:"match_timeout"`
+}
+
+type TableConfig struct {
+	Port        string `yaml:"port"`
+	PlayerAddr  string `yaml:"player_addr"`
+	MetricsPort string `yaml:"metrics_port"`
+}
+
+type MySQLConfig struct {
+	DSN string `yaml:"dsn"`
+}
+
+type TurnLogConfig struct {
+	// Spec is a comma-separated list of format:path entries, or none.
+	Spec       string `yaml:"spec"`
+	MaxBytes   int64  `yaml:"max_bytes"`
+	MaxBackups int    `yaml:"max_backups"`
+}
+
+type NATSConfig struct {
+	// URL is empty when match events are not published.
+	URL     string `yaml:"url"`
+	Subject string `yaml:"subject"`
+}
+
+type WebhookConfig struct {
+	URLs   []string `yaml:"urls"`
+	Secret string   `yaml:"secret"`
+}
+
+type LogConfig struct {
+	// Format is text or json.
+	Format string `yaml:"format"`
+	Level  string `yaml:"level"`
+	// Levels override Level for single components, such as mysql: debug.
+	Levels map[string]string `yaml:"levels"`
+}
+
+// Options are the logging options c describes. c must be valid.
+func (c LogConfig) Options() logging.Options {
+	opts := logging.Options{Format: c.Format}
+	opts.Level.UnmarshalText([]byte(c.Level))
+	opts.Levels, _ = logging.ParseLevels(c.Levels)
+	return opts
+}
+
+type TracingConfig struct {
+	// Exporter is none, stdout or otlp.
+	Exporter string `yaml:"exporter"`
+	// Endpoint is the OTLP/gRPC collector spans are sent to with otlp.
+	Endpoint string `yaml:"endpoint"`
+}
+
+const (
+	DefaultPlayerPort = "8888"
+	DefaultTablePort  = "8889"
+)
+
+func Default() Config {
+	return Config{
+		Player: PlayerConfig{
+			Port:        DefaultPlayerPort,
+			TableAddr:   "localhost:" + DefaultTablePort,
+			HTTPPort:    "8080",
+			MetricsPort: "9090",
+			Recovery:    string(grpcAdapter.RecoveryAbort),
+			TurnLog: TurnLogConfig{
+				Spec:       "csv:match_log.csv",
+				MaxBytes:   10 << 20,
+				MaxBackups: 5,
+			},
+			NATS:         NATSConfig{Subject: natsAdapter.DefaultSubjectPrefix},
+			ShotWindow:   2 * time.Second,
+			MatchTimeout: grpcAdapter.DefaultMatchTimeout,
+		},
+		Table: TableConfig{
+			Port:        DefaultTablePort,
+			PlayerAddr:  "localhost:" + DefaultPlayerPort,
+			MetricsPort: "9091",
+		},
+		MySQL:           MySQLConfig{DSN: "root:@tcp(127.0.0.1:3306)/pingpong?parseTime=true"},
+		Game:            domain.DefaultGameRules(),
+		Tracing:         TracingConfig{Exporter: tracing.ExporterNone, Endpoint: "localhost:4317"},
+		Log:             LogConfig{Format: logging.FormatText, Level: "info"},
+		UI:              UIAuto,
+		ShutdownTimeout: 15 * time.Second,
+	}
+}
+
+// Validate reports every invalid setting at once.
+func (c Config) Validate() error {
+	var errs []error
+	check := func(key string, err error) {
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", key, err))
+		}
+	}
+
+	check("player.port", validatePort(c.Player.Port))
+	check("player.table_addr", validateAddr(c.Player.TableAddr))
+	if c.Player.HTTPPort != "" {
+		check("player.http_port", validatePort(c.Player.HTTPPort))
+	}
+	if c.Player.MetricsPort != "" {
+		check("player.metrics_port", validatePort(c.Player.MetricsPort))
+	}
+	_, err := grpcAdapter.ParseRecoveryPolicy(c.Player.Recovery)
+	check("player.recovery", err)
+	if c.Player.TurnLog.MaxBytes < 0 {
+		check("player.turn_log.max_bytes", errors.New("must not be negative"))
+	}
+	if c.Player.TurnLog.MaxBackups < 0 {
+		check("player.turn_log.max_backups", errors.New("must not be negative"))
+	}
+	if c.Player.NATS.URL != "" && c.Player.NATS.Subject == "" {
+		check("player.nats.subject", errors.New("is required when player.nats.url is set"))
+	}
+	for _, raw := range c.Player.AllowedOrigins {
+		u, err := url.Parse(raw)
+		if err == nil && (u.Scheme != "http" && u.Scheme != "https" || u.Host == "" || u.Path != "") {
+			err = fmt.Errorf("%q is not an origin such as https://example.com", raw)
+		}
+		check("player.allowed_origins", err)
+	}
+	for _, raw := range c.Player.Webhooks.URLs {
+		u, err := url.Parse(raw)
+		if err == nil && (u.Scheme != "http" && u.Scheme != "https" || u.Host == "") {
+			err = fmt.Errorf("%q is not an http(s) URL", raw)
+		}
+		check("player.webhooks.urls", err)
+	}
+	if c.Player.ShotWindow <= 0 {
+		check("player.shot_window", errors.New("must be positive"))
+	}
+	if c.Player.MatchTimeout <= 0 {
+		check("player.match_timeout", errors.New("must be positive"))
+	}
+
+	check("table.port", validatePort(c.Table.Port))
+	check("table.player_addr", validateAddr(c.Table.PlayerAddr))
+	if c.Table.MetricsPort != "" {
+		check("table.metrics_port", validatePort(c.Table.MetricsPort))
+	}
+
+	_, err = mysqlDriver.ParseDSN(c.MySQL.DSN)
+	check("mysql.dsn", err)
+	check("game", c.Game.Validate())
+
+	switch c.Tracing.Exporter {
+	case tracing.ExporterNone, tracing.ExporterStdout:
+	case tracing.ExporterOTLP:
+		check("tracing.endpoint", validateAddr(c.Tracing.Endpoint))
+	default:
+		check("tracing.exporter", fmt.Errorf("unknown exporter %q (expected %q, %q or %q)",
+			c.Tracing.Exporter, tracing.ExporterNone, tracing.ExporterStdout, tracing.ExporterOTLP))
+	}
+
+	switch c.Log.Format {
+	case logging.FormatText, logging.FormatJSON:
+	default:
+		check("log.format", fmt.Errorf("unknown format %q (expected %q or %q)", c.Log.Format, logging.FormatText, logging.FormatJSON))
+	}
+	var level slog.Level
+	check("log.level", level.UnmarshalText([]byte(c.Log.Level)))
+	_, err = logging.ParseLevels(c.Log.Levels)
+	check("log.levels", err)
+
+	switch c.UI {
+	case UIAuto, UITerminal, UIKeys, UINone:
+	default:
+		check("ui", fmt.Errorf("unknown ui %q (expected %q, %q, %q or %q)", c.UI, UIAuto, UITerminal, UIKeys, UINone))
+	}
+	// Spans written to stdout would be drawn over the full-screen UI.
+	if c.Tracing.Exporter == tracing.ExporterStdout && (c.UI == UITerminal || c.UI == UIAuto) {
+		check("tracing.exporter", fmt.Errorf("%q cannot be used with ui %q, use ui %q or %q", c.Tracing.Exporter, c.UI, UIKeys, UINone))
+	}
+	if c.ShutdownTimeout <= 0 {
+		check("shutdown_timeout", errors.New("must be positive"))
+	}
+	return errors.Join(errs...)
+}
+
+func validatePort(port string) error {
+	n, err := strconv.Atoi(port)
+	if err != nil || n < 1 || n > 65535 {
+		return fmt.Errorf("%q is not a port number", port)
+	}
+	return nil
+}
+
+func validateAddr(addr string) error {
+	_, port, err := net.SplitHostPort(addr)
+	if err != nil {
+		return err
+	}
+	return validatePort(port)
+}
+
+// Print writes c as YAML, in the same shape a config file takes, with the
+// webhook secret and the MySQL password masked.
+func Print(w io.Writer, c Config) error {
+	if c.Player.Webhooks.Secret != "" {
+		c.Player.Webhooks.Secret = masked
+	}
+	c.MySQL.DSN = maskDSN(c.MySQL.DSN)
+	enc := yaml.NewEncoder(w)
+	enc.SetIndent(2)
+	if err := enc.Encode(c); err != nil {
+		return err
+	}
+	return enc.Close()
+}
+
+const masked = "********"
+
+// maskDSN hides the password in dsn, or all of dsn when it cannot be parsed
+// to find the password.
+func maskDSN(dsn string) string {
+	parsed, err := mysqlDriver.ParseDSN(dsn)
+	if err != nil {
+		return masked
+	}
+	if parsed.Passwd == "" {
+		return dsn
+	}
+	parsed.Passwd = masked
+	return parsed.FormatDSN()
+}
@@ -0,0 +1,59 @@
+package config
+
+import (
+	"flag"
+	"strings"
+	"testing"
+)
+
+func TestPrintMasksSecrets(t *testing.T) {
+	c := Default()
+	c.MySQL.DSN = "pingpong:s3cret@tcp(db:3306)/pingpong?parseTime=true"
+	c.Player.Webhooks.Secret = "hook-secret"
+
+	var out strings.Builder
+	if err := Print(&out, c); err != nil {
+		t.Fatalf("Print: %v", err)
+	}
+	for _, secret := range []string{"s3cret", "hook-secret"} {
+		if strings.Contains(out.String(), secret) {
+			t.Errorf("printed config contains %q:\n%s", secret, out.String())
+		}
+	}
+	if want := "pingpong:********@tcp(db:3306)/pingpong?parseTime=true"; !strings.Contains(out.String(), want) {
+		t.Errorf("printed config does not contain the masked DSN %q:\n%s", want, out.String())
+	}
+}
+
+func TestMaskDSN(t *testing.T) {
+	tests := []struct {
+		dsn  string
+		want string
+	}{
+		{"root:@tcp(127.0.0.1:3306)/pingpong?parseTime=true", "root:@tcp(127.0.0.1:3306)/pingpong?parseTime=true"},
+		{"root:pw@tcp(127.0.0.1:3306)/pingpong", "root:********@tcp(127.0.0.1:3306)/pingpong"},
+		{"not a dsn", masked},
+	}
+	for _, tt := range tests {
+		if got := maskDSN(tt.dsn); got != tt.want {
+			t.Errorf("maskDSN(%q) = %q, want %q", tt.dsn, got, tt.want)
+		}
+	}
+}
+
+func TestLoadEmptyEnvDisablesPorts(t *testing.T) {
+	t.Setenv("PINGPONG_HTTP_PORT", "")
+	t.Setenv("PINGPONG_PLAYER_METRICS_PORT", "")
+	t.Setenv("PINGPONG_MYSQL_DSN", "env:pw@tcp(db:3306)/pingpong")
+
+	cfg, err := Load(flag.NewFlagSet("test", flag.ContinueOnError), nil, PlayerFlags())
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+	if cfg.Player.HTTPPort != "" || cfg.Player.MetricsPort != "" {
+		t.Errorf("http_port %q and metrics_port %q, want both empty", cfg.Player.HTTPPort, cfg.Player.MetricsPort)
+	}
+	if cfg.MySQL.DSN != "env:pw@tcp(db:3306)/pingpong" {
+		t.Errorf("mysql.dsn = %q, want the one from $PINGPONG_MYSQL_DSN", cfg.MySQL.DSN)
+	}
+}
@@ -0,0 +1,247 @@
+package config
+
+import (
+	"bytes"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	// DefaultFile is read from the working directory when it exists and no
+	// other file was named.
+	DefaultFile = "pingpong.yaml"
+	FileEnv     = "PINGPONG_CONFIG"
+)
+
+// setting ties a configuration key to the environment variable that
+// overrides it and to the field it sets.
+type setting struct {
+	key   string
+	env   string
+	usage string
+	field func(*Config) any
+}
+
+var settings = []setting{
+	{"player.port", "PINGPONG_PLAYER_PORT", "port for the Player gRPC service", func(c *Config) any { return &c.Player.Port }},
+	{"player.table_addr", "PINGPONG_TABLE_ADDR", "address of the Table service", func(c *Config) any { return &c.Player.TableAddr }},
+	{"player.http_port", "PINGPONG_HTTP_PORT", "port for the REST API, empty to disable it", func(c *Config) any { return &c.Player.HTTPPort }},
+	{"player.allowed_origins", "PINGPONG_ALLOWED_ORIGINS", "comma-separated origins, e.g. https://scores.example.com, whose pages may open the live scoreboard feed", func(c *Config) any { return &c.Player.AllowedOrigins }},
+	{"player.metrics_port", "PINGPONG_PLAYER_METRICS_PORT", "port for Prometheus metrics, empty to disable them", func(c *Config) any { return &c.Player.MetricsPort }},
+	{"player.recovery", "PINGPONG_RECOVERY", "what to do with unfinished matches on startup: resume or abort", func(c *Config) any { return &c.Player.Recovery }},
+	{"player.turn_log.spec", "PINGPONG_TURN_LOG", "comma-separated turn logs as format:path (csv or jsonl), or none", func(c *Config) any { return &c.Player.TurnLog.Spec }},
+	{"player.turn_log.max_bytes", "PINGPONG_TURN_LOG_MAX_BYTES", "rotate a turn log once it reaches this size, 0 to never rotate", func(c *Config) any { return &c.Player.TurnLog.MaxBytes }},
+	{"player.turn_log.max_backups", "PINGPONG_TURN_LOG_MAX_BACKUPS", "number of rotated turn logs to keep", func(c *Config) any { return &c.Player.TurnLog.MaxBackups }},
+	{"player.nats.url", "PINGPONG_NATS_URL", "publish match events to this NATS server, e.g. nats://127.0.0.1:4222", func(c *Config) any { return &c.Player.NATS.URL }},
+	{"player.nats.subject", "PINGPONG_NATS_SUBJECT", "subject prefix for published match events", func(c *Config) any { return &c.Player.NATS.Subject }},
+	{"player.webhooks.urls", "PINGPONG_WEBHOOK_URLS", "comma-separated URLs to POST match results to", func(c *Config) any { return &c.Player.Webhooks.URLs }},
+	{"player.webhooks.secret", "PINGPONG_WEBHOOK_SECRET", "HMAC secret for signing webhooks", func(c *Config) any { return &c.Player.Webhooks.Secret }},
+	{"player.human", "PINGPONG_HUMAN", "let a human play Player A from the terminal UI", func(c *Config) any { return &c.Player.Human }},
+	{"player.shot_window", "PINGPONG_SHOT_WINDOW", "how long a human player has to hit each ball", func(c *Config) any { return &c.Player.ShotWindow }},
+	{"player.match_timeout", "PINGPONG_MATCH_TIMEOUT", "abort matches still in play after this long, time paused included, such as one whose ball was lost", func(c *Config) any { return &c.Player.MatchTimeout }},
+	{"table.port", "PINGPONG_TABLE_PORT", "port for the Table gRPC service", func(c *Config) any { return &c.Table.Port }},
+	{"table.player_addr", "PINGPONG_PLAYER_ADDR", "address of the Player service", func(c *Config) any { return &c.Table.PlayerAddr }},
+	{"table.metrics_port", "PINGPONG_TABLE_METRICS_PORT", "port for Prometheus metrics, empty to disable them", func(c *Config) any { return &c.Table.MetricsPort }},
+	{"mysql.dsn", "PINGPONG_MYSQL_DSN", "MySQL data source name", func(c *Config) any { return &c.MySQL.DSN }},
+	{"game.turn_limit", "PINGPONG_TURN_LIMIT", "turns Player B plays before the harder hit wins", func(c *Config) any { return &c.Game.TurnLimit }},
+	{"tracing.exporter", "PINGPONG_TRACING", "export traces to stdout, to an OTLP collector (otlp) or not at all (none)", func(c *Config) any { return &c.Tracing.Exporter }},
+	{"tracing.endpoint", "PINGPONG_OTLP_ENDPOINT", "host:port of the OTLP/gRPC collector", func(c *Config) any { return &c.Tracing.Endpoint }},
+	{"log.format", "PINGPONG_LOG_FORMAT", "log output format: text or json", func(c *Config) any { return &c.Log.Format }},
+	{"log.level", "PINGPONG_LOG_LEVEL", "lowest level logged: debug, info, warn or error", func(c *Config) any { return &c.Log.Level }},
+	{"log.levels", "PINGPONG_LOG_LEVELS", "comma-separated component=level overrides, e.g. mysql=debug,table=warn", func(c *Config) any { return &c.Log.Levels }},
+	{"ui", "PINGPONG_UI", "terminal front-end: tui (full screen), keys (key presses and log lines), none (headless) or auto", func(c *Config) any { return &c.UI }},
+	{"shutdown_timeout", "PINGPONG_SHUTDOWN_TIMEOUT", "how long to wait for the match in progress and pending saves on SIGINT/SIGTERM", func(c *Config) any { return &c.ShutdownTimeout }},
+}
+
+// Flags maps configuration keys to the flag that overrides them in a binary.
+// Keys without a flag can still be set from the file or the environment.
+type Flags map[string]string
+
+// PlayerFlags are the flags of a binary that runs the Player service, apart
+// from its port and the Table's address, which depend on the deployment.
+func PlayerFlags() Flags {
+	return Flags{
+		"player.http_port":            "http-port",
+		"player.allowed_origins":      "allowed-origins",
+		"player.metrics_port":         "metrics-port",
+		"player.recovery":             "recovery",
+		"player.turn_log.spec":        "turn-log",
+		"player.turn_log.max_bytes":   "turn-log-max-bytes",
+		"player.turn_log.max_backups": "turn-log-max-backups",
+		"player.nats.url":             "nats-url",
+		"player.nats.subject":         "nats-subject",
+		"player.webhooks.urls":        "webhook-urls",
+		"player.webhooks.secret":      "webhook-secret",
+		"player.human":                "human",
+		"player.shot_window":          "shot-window",
+		"player.match_timeout":        "match-timeout",
+		"mysql.dsn":                   "dsn",
+		"game.turn_limit":             "turn-limit",
+		"tracing.exporter":            "tracing",
+		"tracing.endpoint":            "otlp-endpoint",
+		"log.format":                  "log-format",
+		"log.level":                   "log-level",
+		"log.levels":                  "log-levels",
+		"ui":                          "ui",
+		"shutdown_timeout":            "shutdown-timeout",
+	}
+}
+
+// Load registers -config and the given flags on fs, parses args and returns
+// the defaults overridden by the config file, then the environment, then the
+// flags that were set. The result is not validated, so callers can adjust it
+// first.
+func Load(fs *flag.FlagSet, args []string, flags Flags) (Config, error) {
+	defaults := Default()
+	file := fs.String("config", "", "YAML config file (default $"+FileEnv+", or "+DefaultFile+" if it exists)")
+	byFlag := make(map[string]setting)
+	for _, s := range settings {
+		name, ok := flags[s.key]
+		if !ok {
+			continue
+		}
+		fs.Var(fieldValue{s.field(&defaults)}, name, s.usage+" ("+s.key+", $"+s.env+")")
+		byFlag[name] = s
+	}
+	if err := fs.Parse(args); err != nil {
+		return Config{}, err
+	}
+
+	cfg := Default()
+	if err := readFile(&cfg, *file); err != nil {
+		return Config{}, err
+	}
+	for _, s := range settings {
+		value, ok := os.LookupEnv(s.env)
+		if !ok {
+			continue
+		}
+		if err := setField(s.field(&cfg), value); err != nil {
+			return Config{}, fmt.Errorf("$%s: %w", s.env, err)
+		}
+	}
+
+	var err error
+	fs.Visit(func(f *flag.Flag) {
+		s, ok := byFlag[f.Name]
+		if ok && err == nil {
+			err = setField(s.field(&cfg), f.Value.String())
+		}
+	})
+	return cfg, err
+}
+
+// readFile applies the YAML file at path, falling back to $PINGPONG_CONFIG
+// and then to DefaultFile, which unlike the others may be missing. Keys the
+// file leaves out keep their current values; unknown keys are an error so
+// typos do not go unnoticed.
+func readFile(cfg *Config, path string) error {
+	if path == "" {
+		path = os.Getenv(FileEnv)
+	}
+	optional := path == ""
+	if optional {
+		path = DefaultFile
+	}
+
+	data, err := os.ReadFile(path)
+	if optional && errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to read config file: %w", err)
+	}
+
+	dec := yaml.NewDecoder(bytes.NewReader(data))
+	dec.KnownFields(true)
+	if err := dec.Decode(cfg); err != nil && !errors.Is(err, io.EOF) {
+		return fmt.Errorf("invalid config file %s: %w", path, err)
+	}
+	return nil
+}
+
+// fieldValue exposes a Config field as a flag.Value.
+type fieldValue struct {
+	ptr any
+}
+
+func (v fieldValue) String() string {
+	switch p := v.ptr.(type) {
+	case *string:
+		return *p
+	case *bool:
+		return strconv.FormatBool(*p)
+	case *int:
+		return strconv.Itoa(*p)
+	case *int64:
+		return strconv.FormatInt(*p, 10)
+	case *time.Duration:
+		return p.String()
+	case *[]string:
+		return strings.Join(*p, ",")
+	case *map[string]string:
+		pairs := make([]string, 0, len(*p))
+		for key, value := range *p {
+			pairs = append(pairs, key+"="+value)
+		}
+		sort.Strings(pairs)
+		return strings.Join(pairs, ",")
+	}
+	return ""
+}
+
+func (v fieldValue) Set(value string) error {
+	return setField(v.ptr, value)
+}
+
+func (v fieldValue) IsBoolFlag() bool {
+	_, ok := v.ptr.(*bool)
+	return ok
+}
+
+func setField(ptr any, value string) error {
+	var err error
+	switch p := ptr.(type) {
+	case *string:
+		*p = value
+	case *bool:
+		*p, err = strconv.ParseBool(value)
+	case *int:
+		*p, err = strconv.Atoi(value)
+	case *int64:
+		*p, err = strconv.ParseInt(value, 10, 64)
+	case *time.Duration:
+		*p, err = time.ParseDuration(value)
+	case *[]string:
+		*p = nil
+		for _, item := range strings.Split(value, ",") {
+			if item = strings.TrimSpace(item); item != "" {
+				*p = append(*p, item)
+			}
+		}
+	case *map[string]string:
+		*p = make(map[string]string)
+		for _, item := range strings.Split(value, ",") {
+			if item = strings.TrimSpace(item); item == "" {
+				continue
+			}
+			key, val, ok := strings.Cut(item, "=")
+			if !ok {
+				return fmt.Errorf("%q is not a key=value pair", item)
+			}
+			(*p)[strings.TrimSpace(key)] = strings.TrimSpace(val)
+		}
+	default:
+		err = fmt.Errorf("unsupported setting type %T", ptr)
+	}
+	return err
+}
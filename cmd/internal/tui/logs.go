@@ -0,0 +1,40 @@
+package tui
+
+import (
+	"strings"
+	"sync"
+)
+
+const logHistory = 100
+
+// logBuffer keeps the latest log lines so they can be shown in a panel
+// instead of scrolling over the screen.
+type logBuffer struct {
+	mu      sync.Mutex
+	lines   []string
+	partial string
+}
+
+func (b *logBuffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	text := b.partial + string(p)
+	parts := strings.Split(text, "\n")
+	b.partial = parts[len(parts)-1]
+	b.lines = append(b.lines, parts[:len(parts)-1]...)
+	if len(b.lines) > logHistory {
+		b.lines = b.lines[len(b.lines)-logHistory:]
+	}
+	return len(p), nil
+}
+
+func (b *logBuffer) last(n int) []string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if len(b.lines) < n {
+		n = len(b.lines)
+	}
+	return append([]string(nil), b.lines[len(b.lines)-n:]...)
+}
@@ -0,0 +1,172 @@
+package tui
+
+import (
+	"time"
+
+	pb "pingpong/proto"
+)
+
+const (
+	recentLimit = 20
+	liveStep    = 250 * time.Millisecond
+	replayStep  = 400 * time.Millisecond
+)
+
+// model is everything the screen shows. It is only touched by the UI loop.
+type model struct {
+	matchID     int32
+	matchNumber int32
+	rally       int32
+	power       int32
+	lastPlayer  string
+	winner      string
+	active      bool
+	paused      bool
+	replaying   bool
+
+	// The ball travels from ballFrom to ballTarget, 0 being A's end of the
+	// table and 1 B's.
+	ballFrom   float64
+	ballPos    float64
+	ballTarget float64
+
+	// Updates are played back one step apart so fast rallies stay visible.
+	// A nil entry marks the end of a replay.
+	queue       []*pb.MatchUpdate
+	lastApplied time.Time
+
+	// Set while a human Player A has to hit the ball.
+	shotDeadline  time.Time
+	shotWindow    time.Duration
+	shotIncoming  int32
+	shotPlacement pb.ShotPlacement
+
+	recent []*pb.Match
+	status string
+	logs   *logBuffer
+}
+
+func newModel(logs *logBuffer) *model {
+	return &model{ballPos: 0.5, ballFrom: 0.5, ballTarget: 0.5, logs: logs, status: "Ready"}
+}
+
+func (m *model) enqueue(update *pb.MatchUpdate) {
+	// Live play stays in the background while a replay is on the table.
+	if m.replaying && !update.Replay {
+		return
+	}
+	m.queue = append(m.queue, update)
+}
+
+// tick advances the ball animation and applies the next queued update once
+// its step has elapsed.
+func (m *model) tick(now time.Time) {
+	m.ballPos += (m.ballTarget - m.ballPos) * 0.35
+	if m.shotPending() && now.After(m.shotDeadline) {
+		m.shotDeadline = time.Time{}
+	}
+
+	step := liveStep
+	if m.replaying {
+		step = replayStep
+	}
+	if len(m.queue) == 0 || now.Sub(m.lastApplied) < step {
+		return
+	}
+	update := m.queue[0]
+	m.queue = m.queue[1:]
+	m.lastApplied = now
+	if update == nil {
+		m.replaying = false
+		m.status = "Replay finished"
+		return
+	}
+	m.apply(update)
+}
+
+func (m *model) apply(u *pb.MatchUpdate) {
+	switch u.Kind {
+	case pb.MatchUpdate_MATCH_STARTED:
+		m.matchID = u.MatchId
+		m.matchNumber = u.MatchNumber
+		m.rally = 0
+		m.power = 0
+		m.lastPlayer = ""
+		m.winner = ""
+		m.active = true
+		m.paused = false
+		m.moveBall(0.5)
+	case pb.MatchUpdate_TURN:
+		m.rally = u.Turn.TurnNumber
+		m.power = u.Turn.BallPower
+		m.lastPlayer = u.Turn.Player
+		if u.Turn.Player == "A" {
+			m.moveBall(0)
+		} else {
+			m.moveBall(1)
+		}
+	case pb.MatchUpdate_PAUSED:
+		m.paused = true
+	case pb.MatchUpdate_RESUMED:
+		m.paused = false
+	case pb.MatchUpdate_MATCH_FINISHED:
+		m.winner = u.Winner
+		if m.winner == "" {
+			m.winner = "-"
+		}
+		m.active = false
+		m.paused = false
+	}
+}
+
+// requestShot prompts for a shot. It skips the playback queue, since the
+// timing window is already running.
+func (m *model) requestShot(u *pb.MatchUpdate) {
+	m.shotDeadline = u.Deadline.AsTime()
+	m.shotWindow = time.Until(m.shotDeadline)
+	m.shotIncoming = u.Turn.BallPower
+	m.shotPlacement = pb.ShotPlacement_SHOT_PLACEMENT_CENTER
+}
+
+func (m *model) shotPending() bool {
+	return !m.shotDeadline.IsZero()
+}
+
+func (m *model) moveBall(target float64) {
+	m.ballFrom = m.ballPos
+	m.ballTarget = target
+}
+
+// flight is how far along its current path the ball is, from 0 to 1.
+func (m *model) flight() float64 {
+	distance := m.ballTarget - m.ballFrom
+	if distance == 0 {
+		return 1
+	}
+	return (m.ballPos - m.ballFrom) / distance
+}
+
+// score counts wins over the recent matches.
+func (m *model) score() (a int, b int, draws int) {
+	for _, match := range m.recent {
+		switch match.Winner {
+		case "A":
+			a++
+		case "B":
+			b++
+		case "Draw":
+			draws++
+		}
+	}
+	return a, b, draws
+}
+
+// lastFinished is the most recent match that can be replayed.
+func (m *model) lastFinished() *pb.Match {
+	for _, match := range m.recent {
+		if match.EndTime != nil && len(match.Turns) > 0 {
+			return match
+		}
+	}
+	return nil
+}
@@ -0,0 +1,192 @@
+package tui
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	pb "pingpong/proto"
+)
+
+const (
+	screenWidth  = 80
+	tableWidth   = 50 // inside the border
+	tableRows    = 4
+	scoreWidth   = 24
+	recentRows   = 5
+	logRows      = 4
+	clearLine    = "\x1b[K"
+	enterScreen  = "\x1b[?1049h\x1b[?25l\x1b[2J"
+	leaveScreen  = "\x1b[?25h\x1b[?1049l"
+	timeLayout   = "01-02 15:04:05"
+	ballRune     = '●'
+	netRune      = '┊'
+	powerBarSize = 20
+	shotBarSize  = 10
+)
+
+// render draws a full frame. Lines are placed with absolute cursor moves
+// because the keyboard puts the terminal in raw mode.
+func render(m *model) string {
+	var lines []string
+	add := func(format string, args ...any) {
+		lines = append(lines, fmt.Sprintf(format, args...))
+	}
+
+	add(" PingPong %s", pad(titleStatus(m), screenWidth-10))
+
+	table := tableLines(m)
+	score := scoreLines(m)
+	for i := range table {
+		add("%s %s", table[i], score[i])
+	}
+
+	bar := 0
+	if m.power > 0 {
+		bar = min(powerBarSize, int(m.power)*powerBarSize/100)
+	}
+	add(" Rally %-4d Power %3d %s%s  Last hit: %s",
+		m.rally, m.power, strings.Repeat("█", bar), strings.Repeat("░", powerBarSize-bar), orDash(m.lastPlayer))
+
+	lines = append(lines, box("Recent matches", screenWidth-2, recentLines(m)...)...)
+
+	// Log lines may hold wide emoji, so they get no right-hand border.
+	add("─ Log %s", strings.Repeat("─", screenWidth-6))
+	logs := m.logs.last(logRows)
+	for i := 0; i < logRows; i++ {
+		if i < len(logs) {
+			add(" %s", truncate(logs[i], screenWidth-2))
+		} else {
+			add("")
+		}
+	}
+
+	if m.shotPending() {
+		add("%s", shotPrompt(m))
+	} else {
+		add(" [Space] new match  [P] pause/resume  [R] replay last  [F2] test DB  [Esc] exit")
+	}
+	add(" %s", truncate(m.status, screenWidth-2))
+
+	var b strings.Builder
+	for i, line := range lines {
+		fmt.Fprintf(&b, "\x1b[%d;1H%s%s", i+1, line, clearLine)
+	}
+	return b.String()
+}
+
+func shotPrompt(m *model) string {
+	placement := map[pb.ShotPlacement]string{
+		pb.ShotPlacement_SHOT_PLACEMENT_LEFT:   "LEFT",
+		pb.ShotPlacement_SHOT_PLACEMENT_CENTER: "CENTER",
+		pb.ShotPlacement_SHOT_PLACEMENT_RIGHT:  "RIGHT",
+	}[m.shotPlacement]
+
+	left := max(time.Until(m.shotDeadline), 0)
+	bar := 0
+	if m.shotWindow > 0 {
+		bar = min(shotBarSize, int(float64(shotBarSize)*float64(left)/float64(m.shotWindow)))
+	}
+	return fmt.Sprintf(" HIT! in %3d  [←↓→] %-6s  [1/2/3] soft/medium/hard  %.1fs %s%s",
+		m.shotIncoming, placement, left.Seconds(), strings.Repeat("█", bar), strings.Repeat("░", shotBarSize-bar))
+}
+
+func titleStatus(m *model) string {
+	state := "waiting for a match"
+	switch {
+	case m.replaying:
+		state = "REPLAY"
+	case m.paused:
+		state = "PAUSED"
+	case m.active:
+		state = "LIVE"
+	case m.winner != "":
+		state = "finished, winner: " + m.winner
+	}
+	if m.matchNumber == 0 {
+		return "│ " + state
+	}
+	return fmt.Sprintf("│ Match #%d (ID %d) │ %s", m.matchNumber, m.matchID, state)
+}
+
+func tableLines(m *model) []string {
+	rows := make([][]rune, tableRows)
+	for i := range rows {
+		rows[i] = []rune(strings.Repeat(" ", tableWidth))
+		rows[i][tableWidth/2] = netRune
+	}
+	rows[0][0] = 'A'
+	rows[0][tableWidth-1] = 'B'
+
+	// The ball arcs over the net: highest halfway through its flight.
+	p := min(max(m.flight(), 0), 1)
+	height := int(math.Round(float64(tableRows-2) * 4 * p * (1 - p)))
+	row := tableRows - 1 - height
+	col := 1 + int(math.Round(min(max(m.ballPos, 0), 1)*float64(tableWidth-3)))
+	rows[row][col] = ballRune
+
+	content := make([]string, tableRows)
+	for i, r := range rows {
+		content[i] = string(r)
+	}
+	return box("Table", tableWidth, content...)
+}
+
+func scoreLines(m *model) []string {
+	a, b, draws := m.score()
+	return box("Score", scoreWidth,
+		fmt.Sprintf(" Player A  %5d", a),
+		fmt.Sprintf(" Player B  %5d", b),
+		fmt.Sprintf(" Draws     %5d", draws),
+		fmt.Sprintf(" (last %d matches)", len(m.recent)),
+	)
+}
+
+func recentLines(m *model) []string {
+	lines := []string{fmt.Sprintf(" %-6s %-7s %-16s %-6s %s", "ID", "MATCH", "STARTED", "TURNS", "WINNER")}
+	for i := 0; i < recentRows-1; i++ {
+		if i >= len(m.recent) {
+			lines = append(lines, "")
+			continue
+		}
+		match := m.recent[i]
+		started := "-"
+		if match.StartTime != nil {
+			started = match.StartTime.AsTime().Local().Format(timeLayout)
+		}
+		lines = append(lines, fmt.Sprintf(" %-6d #%-6d %-16s %-6d %s",
+			match.Id, match.MatchNumber, started, len(match.Turns), orDash(match.Winner)))
+	}
+	return lines
+}
+
+// box frames content lines of the given inner width.
+func box(title string, width int, content ...string) []string {
+	top := "┌─ " + title + " " + strings.Repeat("─", max(0, width-3-utf8.RuneCountInString(title))) + "┐"
+	lines := []string{top}
+	for _, line := range content {
+		lines = append(lines, "│"+pad(line, width)+"│")
+	}
+	return append(lines, "└"+strings.Repeat("─", width)+"┘")
+}
+
+func pad(s string, width int) string {
+	s = truncate(s, width)
+	return s + strings.Repeat(" ", width-utf8.RuneCountInString(s))
+}
+
+func truncate(s string, width int) string {
+	if utf8.RuneCountInString(s) <= width {
+		return s
+	}
+	return string([]rune(s)[:width])
+}
+
+func orDash(s string) string {
+	if s == "" {
+		return "-"
+	}
+	return s
+}
@@ -0,0 +1,264 @@
+// Package tui is a full-screen terminal front-end for the Player service. It
+// follows matches live through WatchMatch and keeps the keyboard controls of
+// the plain key loop, plus pause and replay.
+package tui
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/eiannone/keyboard"
+
+	grpcAdapter "pingpong/adapters/grpc"
+	"pingpong/logging"
+	pb "pingpong/proto"
+)
+
+const (
+	frameInterval   = 50 * time.Millisecond
+	requestTimeout  = 5 * time.Second
+	reconnectDelay  = time.Second
+	keyBufferSize   = 10
+	actionQueueSize = 64
+)
+
+var logger = logging.For(logging.ComponentApp)
+
+// ui owns the screen. Background work reports back through actions, which
+// the loop in Run applies to the model one at a time.
+type ui struct {
+	client  pb.PlayerServiceClient
+	model   *model
+	actions chan func(*model)
+	out     io.Writer
+}
+
+// Run takes over the terminal until Esc is pressed or ctx is done, then
+// restores it. Log output is shown in a panel meanwhile.
+func Run(ctx context.Context, client pb.PlayerServiceClient) error {
+	keys, err := keyboard.GetKeys(keyBufferSize)
+	if err != nil {
+		return fmt.Errorf("failed to open keyboard: %w", err)
+	}
+	defer keyboard.Close()
+
+	logs := &logBuffer{}
+	logging.SetOutput(logs)
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	u := &ui{
+		client:  client,
+		model:   newModel(logs),
+		actions: make(chan func(*model), actionQueueSize),
+		out:     os.Stdout,
+	}
+	fmt.Fprint(u.out, enterScreen)
+	defer func() {
+		fmt.Fprint(u.out, leaveScreen)
+		logging.SetOutput(os.Stderr)
+	}()
+
+	go u.watch(ctx)
+	u.refreshRecent()
+
+	ticker := time.NewTicker(frameInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case event := <-keys:
+			if event.Err != nil {
+				u.model.status = "Keyboard error: " + event.Err.Error()
+				continue
+			}
+			if event.Key == keyboard.KeyEsc || event.Key == keyboard.KeyCtrlC {
+				return nil
+			}
+			u.handleKey(event)
+		case action := <-u.actions:
+			action(u.model)
+		case now := <-ticker.C:
+			u.model.tick(now)
+			fmt.Fprint(u.out, render(u.model))
+		}
+	}
+}
+
+// Keys for a human Player A's shot.
+var (
+	shotPowers = map[rune]pb.ShotPower{
+		'1': pb.ShotPower_SHOT_POWER_SOFT,
+		'2': pb.ShotPower_SHOT_POWER_MEDIUM,
+		'3': pb.ShotPower_SHOT_POWER_HARD,
+	}
+	shotPlacements = map[keyboard.Key]pb.ShotPlacement{
+		keyboard.KeyArrowLeft:  pb.ShotPlacement_SHOT_PLACEMENT_LEFT,
+		keyboard.KeyArrowDown:  pb.ShotPlacement_SHOT_PLACEMENT_CENTER,
+		keyboard.KeyArrowRight: pb.ShotPlacement_SHOT_PLACEMENT_RIGHT,
+	}
+)
+
+func (u *ui) handleKey(event keyboard.KeyEvent) {
+	m := u.model
+	if m.shotPending() {
+		if placement, ok := shotPlacements[event.Key]; ok {
+			m.shotPlacement = placement
+			return
+		}
+		if power, ok := shotPowers[event.Rune]; ok {
+			placement := m.shotPlacement
+			m.shotDeadline = time.Time{}
+			u.do(func(ctx context.Context) string {
+				res, err := grpcAdapter.HitBall(ctx, u.client, power, placement)
+				switch {
+				case err != nil:
+					return "Swing failed: " + err.Error()
+				case res.Out:
+					return fmt.Sprintf("Out! The ball went long at power %d", res.ReturnPower)
+				default:
+					return fmt.Sprintf("You hit the ball back with power %d", res.ReturnPower)
+				}
+			})
+			return
+		}
+	}
+
+	switch {
+	case event.Key == keyboard.KeySpace:
+		m.status = "Starting a new match..."
+		u.do(func(ctx context.Context) string {
+			if _, err := grpcAdapter.StartNewMatch(ctx, u.client); err != nil {
+				return "Failed to start match: " + err.Error()
+			}
+			return "Match started"
+		})
+
+	case event.Key == keyboard.KeyF2:
+		m.status = "Testing DB..."
+		u.do(func(ctx context.Context) string {
+			res, err := grpcAdapter.TestDB(ctx, u.client)
+			if err != nil {
+				return "TestDB failed: " + err.Error()
+			}
+			return fmt.Sprintf("TestDB successful (latency %.2f ms, schema version %d)", res.LatencyMs, res.SchemaVersion)
+		})
+
+	case event.Rune == 'p' || event.Rune == 'P':
+		paused := !m.paused
+		u.do(func(ctx context.Context) string {
+			res, err := grpcAdapter.PauseMatch(ctx, u.client, paused)
+			if err != nil {
+				return "Pause failed: " + err.Error()
+			}
+			if res.Paused {
+				return "Match paused"
+			}
+			return "Match resumed"
+		})
+
+	case event.Rune == 'r' || event.Rune == 'R':
+		match := m.lastFinished()
+		switch {
+		case m.replaying:
+			m.status = "A replay is already running"
+		case match == nil:
+			m.status = "No finished match to replay"
+		default:
+			m.replaying = true
+			m.queue = nil
+			m.status = fmt.Sprintf("Replaying match #%d (ID %d)", match.MatchNumber, match.Id)
+			go u.replay(match.Id)
+		}
+	}
+}
+
+// do runs an RPC off the UI loop and shows the message it returns.
+func (u *ui) do(call func(ctx context.Context) string) {
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
+		defer cancel()
+		status := call(ctx)
+		u.actions <- func(m *model) { m.status = status }
+	}()
+}
+
+func (u *ui) refreshRecent() {
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
+		defer cancel()
+		res, err := grpcAdapter.ListMatches(ctx, u.client, &pb.ListMatchesRequest{Limit: recentLimit})
+		if err != nil {
+			logger.Warn("⚠️ Failed to load recent matches", "err", err)
+			return
+		}
+		// ListMatches returns oldest first; the panel shows newest first.
+		recent := make([]*pb.Match, len(res.Matches))
+		for i, match := range res.Matches {
+			recent[len(recent)-1-i] = match
+		}
+		u.actions <- func(m *model) { m.recent = recent }
+	}()
+}
+
+// watch follows every live match, reconnecting if the stream drops.
+func (u *ui) watch(ctx context.Context) {
+	for ctx.Err() == nil {
+		stream, err := grpcAdapter.WatchMatch(ctx, u.client, 0)
+		if err == nil {
+			err = u.receive(stream)
+		}
+		if ctx.Err() != nil {
+			return
+		}
+		logger.Warn("⚠️ Live updates interrupted, reconnecting", "err", err)
+		time.Sleep(reconnectDelay)
+	}
+}
+
+func (u *ui) replay(id int32) {
+	defer func() {
+		u.actions <- func(m *model) { m.queue = append(m.queue, nil) }
+	}()
+
+	// The UI paces the replay itself, so ask for every update at once.
+	stream, err := grpcAdapter.ReplayMatch(context.Background(), u.client, id, 0)
+	if err == nil {
+		err = u.receive(stream)
+	}
+	if err != nil && !errors.Is(err, io.EOF) {
+		u.actions <- func(m *model) { m.status = "Replay failed: " + err.Error() }
+	}
+}
+
+type updateStream interface {
+	Recv() (*pb.MatchUpdate, error)
+}
+
+func (u *ui) receive(stream updateStream) error {
+	for {
+		update, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		u.actions <- func(m *model) {
+			if update.Kind == pb.MatchUpdate_SHOT_REQUESTED {
+				m.requestShot(update)
+				return
+			}
+			m.enqueue(update)
+			if update.Kind == pb.MatchUpdate_MATCH_FINISHED && !update.Replay {
+				u.refreshRecent()
+			}
+		}
+	}
+}
@@ -1,121 +1,103 @@
+// Command pingpong is the combined dev binary: it runs the Player and Table
+// services in one process, wired over localhost, and hosts the export, import,
+// replay, config and cleanup subcommands. Use cmd/player and cmd/table to
+// deploy the services separately.
 package main
 
 import (
 	"context"
-	"log"
+	"flag"
 	"os"
-	"time"
 
-	"github.com/eiannone/keyboard"
-	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
-
-	grpcAdapter "pingpong/adapters/grpc"
-	"pingpong/adapters/mysql"
-	"pingpong/proto"
-	"pingpong/service"
+	"pingpong/cmd/internal/app"
+	"pingpong/cmd/internal/config"
+	"pingpong/logging"
 )
 
-const (
-	PlayersPort = "8888"
-	TablePort   = "8889"
-)
+var logger = logging.For(logging.ComponentApp)
 
 func main() {
-	log.SetFlags(log.Ldate | log.Ltime | log.Lmicroseconds | log.Lshortfile)
-	log.Println("🚀 Starting PingPong Bot Application with gRPC")
-	log.Println("📝 Creating log file")
-
-	f, err := os.Create("match_log.csv")
-	if err != nil {
-		log.Fatalf("❌ Failed to create log file: %v", err)
+	if len(os.Args) > 1 {
+		switch os.Args[1] {
+		case "export":
+			runExport(os.Args[2:])
+			return
+		case "import":
+			runImport(os.Args[2:])
+			return
+		case "replay":
+			runReplay(os.Args[2:])
+			return
+		case "config":
+			runConfig(os.Args[2:])
+			return
+		case "cleanup":
+			runCleanup(os.Args[2:])
+			return
+		}
 	}
-	f.WriteString("time,turn_number,player,ball_power,routine_id,match_number\n")
-	f.Close()
 
-	log.Println("🔌 Connecting to MySQL database...")
-	mysqlConfig := "root:@tcp(127.0.0.1:3306)/pingpong?parseTime=true"
-	repo, err := mysql.NewMySQLRepository(mysqlConfig)
+	cfg, err := loadConfig(flag.CommandLine, os.Args[1:])
 	if err != nil {
-		log.Printf("⚠️ Database connection issue: %v", err)
+		logger.Error("❌ Invalid configuration", "err", err)
+		os.Exit(1)
 	}
-	matchService := service.NewMatchService(repo)
-
-	playerServer := grpcAdapter.NewPlayerServer(matchService, nil)
-	tableServer := grpcAdapter.NewTableServer(nil)
 
-	go grpcAdapter.StartGRPCServer(playerServer, PlayersPort)
-	go grpcAdapter.StartGRPCServer(tableServer, TablePort)
+	if err := logging.Setup(cfg.Log.Options()); err != nil {
+		logger.Error("❌ Failed to set up logging", "err", err)
+		os.Exit(1)
+	}
+	logger.Info("🚀 Starting PingPong Bot Application with gRPC")
 
-	log.Println("⏳ Waiting for gRPC servers to start...")
-	time.Sleep(2 * time.Second)
+	flushTraces, err := app.StartTracing(cfg, "pingpong")
+	if err != nil {
+		logger.Error("❌ Failed to set up tracing", "err", err)
+		os.Exit(1)
+	}
 
-	playerAddr := "localhost:" + PlayersPort
-	tableAddr := "localhost:" + TablePort
+	ctx, stop := app.SignalContext()
+	defer stop()
 
-	playerConn, err := grpc.Dial(playerAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	table, err := app.StartTable(cfg)
 	if err != nil {
-		log.Fatalf("❌ Failed to connect to Player service: %v", err)
+		logger.Error("❌ Failed to start Table service", "err", err)
+		os.Exit(1)
 	}
-	defer playerConn.Close()
 
-	tableConn, err := grpc.Dial(tableAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	player, err := app.StartPlayer(cfg)
 	if err != nil {
-		log.Fatalf("❌ Failed to connect to Table service: %v", err)
+		logger.Error("❌ Failed to start Player service", "err", err)
+		os.Exit(1)
 	}
-	defer tableConn.Close()
-
-	playerServer.TableClient = grpcAdapter.NewTableClientFromConn(tableConn)
-	tableServer.PlayerClient = grpcAdapter.NewPlayerClientFromConn(playerConn)
-
-	log.Println("✅ Services started successfully")
-	log.Println("🎮 Press Space Bar to start a new match, F2 to test DB, ESC to exit")
 
-	go func() {
-		if err := keyboard.Open(); err != nil {
-			log.Fatalf("❌ Failed to open keyboard: %v", err)
-		}
-		defer keyboard.Close()
-
-		playerClient := proto.NewPlayerServiceClient(playerConn)
-
-		for {
-			char, key, err := keyboard.GetKey()
-			if err != nil {
-				log.Printf("⚠️ Error reading key: %v", err)
-				continue
-			}
-
-			switch key {
-			case keyboard.KeySpace:
-				log.Println("🏓 Space Bar pressed - Starting a new match...")
-				_, err := playerClient.StartNewMatch(context.Background(), &proto.NewMatchRequest{})
-				if err != nil {
-					log.Printf("❌ Failed to start match: %v", err)
-				} else {
-					log.Println("✅ Match started successfully")
-				}
-
-			case keyboard.KeyF2:
-				log.Println("🧪 F2 pressed - Testing DB...")
-				_, err := playerClient.TestDB(context.Background(), &proto.TestDBRequest{})
-				if err != nil {
-					log.Printf("❌ TestDB failed: %v", err)
-				} else {
-					log.Println("✅ TestDB successful")
-				}
-
-			case keyboard.KeyEsc:
-				log.Println("👋 ESC pressed - Exiting...")
-				os.Exit(0)
+	logger.Info("✅ Services started successfully")
+	go app.ReloadOnHangup(ctx, func() (config.Config, error) {
+		return loadConfig(flag.NewFlagSet("reload", flag.ContinueOnError), os.Args[1:])
+	}, player.Server, table.Server)
+	app.RunUI(ctx, cfg.UI, cfg.Table.PlayerAddr)
+
+	// The Player goes first so a match in progress can still reach the Table.
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), cfg.ShutdownTimeout)
+	defer cancel()
+	player.Shutdown(shutdownCtx)
+	table.Shutdown(shutdownCtx)
+	flushTraces(shutdownCtx)
+}
 
-			default:
-				if char != 0 {
-					log.Printf("🔘 Key pressed: %q", char)
-				}
-			}
-		}
-	}()
+// loadConfig reads the dev binary's configuration. Both services run in this
+// process, so each one's peer address follows the other's port, and their
+// metrics are served together on player.metrics_port.
+func loadConfig(fs *flag.FlagSet, args []string) (config.Config, error) {
+	flags := config.PlayerFlags()
+	flags["player.port"] = "player-port"
+	flags["table.port"] = "table-port"
 
-	select {}
+	cfg, err := config.Load(fs, args, flags)
+	if err != nil {
+		return cfg, err
+	}
+	cfg.Player.TableAddr = "localhost:" + cfg.Table.Port
+	cfg.Table.PlayerAddr = "localhost:" + cfg.Player.Port
+	cfg.Table.MetricsPort = ""
+	return cfg, cfg.Validate()
 }
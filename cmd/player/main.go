@@ -0,0 +1,66 @@
+// Command player runs the Player service on its own. It finds the Table
+// service through -table-addr, so the two can run on different machines.
+package main
+
+import (
+	"context"
+	"flag"
+	"os"
+
+	"pingpong/cmd/internal/app"
+	"pingpong/cmd/internal/config"
+	"pingpong/logging"
+)
+
+var logger = logging.For(logging.ComponentApp)
+
+func main() {
+	cfg, err := loadConfig(flag.CommandLine, os.Args[1:])
+	if err != nil {
+		logger.Error("❌ Invalid configuration", "err", err)
+		os.Exit(1)
+	}
+
+	if err := logging.Setup(cfg.Log.Options()); err != nil {
+		logger.Error("❌ Failed to set up logging", "err", err)
+		os.Exit(1)
+	}
+	logger.Info("🚀 Starting PingPong Player service")
+
+	flushTraces, err := app.StartTracing(cfg, "pingpong-player")
+	if err != nil {
+		logger.Error("❌ Failed to set up tracing", "err", err)
+		os.Exit(1)
+	}
+
+	player, err := app.StartPlayer(cfg)
+	if err != nil {
+		logger.Error("❌ Failed to start Player service", "err", err)
+		os.Exit(1)
+	}
+
+	logger.Info("✅ Player service started successfully")
+	ctx, stop := app.SignalContext()
+	defer stop()
+	go app.ReloadOnHangup(ctx, func() (config.Config, error) {
+		return loadConfig(flag.NewFlagSet("reload", flag.ContinueOnError), os.Args[1:])
+	}, player.Server)
+	app.RunUI(ctx, cfg.UI, "localhost:"+cfg.Player.Port)
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), cfg.ShutdownTimeout)
+	defer cancel()
+	player.Shutdown(shutdownCtx)
+	flushTraces(shutdownCtx)
+}
+
+func loadConfig(fs *flag.FlagSet, args []string) (config.Config, error) {
+	flags := config.PlayerFlags()
+	flags["player.port"] = "port"
+	flags["player.table_addr"] = "table-addr"
+
+	cfg, err := config.Load(fs, args, flags)
+	if err != nil {
+		return cfg, err
+	}
+	return cfg, cfg.Validate()
+}
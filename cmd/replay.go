@@ -0,0 +1,82 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"strconv"
+	"strings"
+
+	"pingpong/cmd/internal/config"
+	"pingpong/proto"
+)
+
+const courtWidth = 21
+
+func runReplay(args []string) {
+	fs := flag.NewFlagSet("replay", flag.ExitOnError)
+	addr := fs.String("addr", "localhost:"+config.DefaultPlayerPort, "Player service address")
+	speed := fs.Float64("speed", 1, "playback speed multiplier, 0 to print the whole match at once")
+	fs.Parse(args)
+
+	if fs.NArg() != 1 {
+		log.Fatalf("❌ Usage: pingpong replay [-addr ADDR] [-speed N] <match-id>")
+	}
+	id, err := strconv.Atoi(fs.Arg(0))
+	if err != nil {
+		log.Fatalf("❌ Invalid match ID %q", fs.Arg(0))
+	}
+
+	conn, playerClient := dialPlayerService(*addr)
+	defer conn.Close()
+
+	stream, err := playerClient.ReplayMatch(context.Background(), &proto.ReplayMatchRequest{
+		MatchId: int32(id),
+		Speed:   *speed,
+	})
+	if err != nil {
+		log.Fatalf("❌ Replay failed: %v", err)
+	}
+
+	for {
+		update, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return
+		}
+		if err != nil {
+			log.Fatalf("❌ Replay failed: %v", err)
+		}
+		fmt.Println(renderUpdate(update))
+	}
+}
+
+// renderUpdate draws one update as a line of the court, with the ball on the
+// side of the player who received it.
+func renderUpdate(update *proto.MatchUpdate) string {
+	switch update.Kind {
+	case proto.MatchUpdate_MATCH_STARTED:
+		return fmt.Sprintf("🏓 Match #%d (ID %d) started at %s",
+			update.MatchNumber, update.MatchId, update.Time.AsTime().Local().Format("2006-01-02 15:04:05"))
+	case proto.MatchUpdate_TURN:
+		turn := update.Turn
+		court := []rune(strings.Repeat("·", courtWidth))
+		court[courtWidth/2] = '|'
+		if turn.Player == "A" {
+			court[0] = '●'
+		} else {
+			court[courtWidth-1] = '●'
+		}
+		return fmt.Sprintf("#%-3d A %s B  %s receives %3d %s",
+			turn.TurnNumber, string(court), turn.Player, turn.BallPower, strings.Repeat("█", int(turn.BallPower)/10))
+	case proto.MatchUpdate_MATCH_FINISHED:
+		if update.Winner == "" {
+			return "🏁 Match finished"
+		}
+		return fmt.Sprintf("🏁 Match finished, winner: %s", update.Winner)
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,74 @@
+// Command table runs the Table service on its own. It finds the Player
+// service through -player-addr, so the two can run on different machines.
+package main
+
+import (
+	"context"
+	"flag"
+	"os"
+
+	"pingpong/cmd/internal/app"
+	"pingpong/cmd/internal/config"
+	"pingpong/logging"
+)
+
+var logger = logging.For(logging.ComponentApp)
+
+func main() {
+	cfg, err := loadConfig(flag.CommandLine, os.Args[1:])
+	if err != nil {
+		logger.Error("❌ Invalid configuration", "err", err)
+		os.Exit(1)
+	}
+
+	if err := logging.Setup(cfg.Log.Options()); err != nil {
+		logger.Error("❌ Failed to set up logging", "err", err)
+		os.Exit(1)
+	}
+	logger.Info("🚀 Starting PingPong Table service")
+
+	flushTraces, err := app.StartTracing(cfg, "pingpong-table")
+	if err != nil {
+		logger.Error("❌ Failed to set up tracing", "err", err)
+		os.Exit(1)
+	}
+
+	table, err := app.StartTable(cfg)
+	if err != nil {
+		logger.Error("❌ Failed to start Table service", "err", err)
+		os.Exit(1)
+	}
+
+	logger.Info("✅ Table service started successfully")
+	ctx, stop := app.SignalContext()
+	defer stop()
+	go app.ReloadOnHangup(ctx, func() (config.Config, error) {
+		return loadConfig(flag.NewFlagSet("reload", flag.ContinueOnError), os.Args[1:])
+	}, table.Server)
+	<-ctx.Done()
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), cfg.ShutdownTimeout)
+	defer cancel()
+	table.Shutdown(shutdownCtx)
+	flushTraces(shutdownCtx)
+}
+
+func loadConfig(fs *flag.FlagSet, args []string) (config.Config, error) {
+	cfg, err := config.Load(fs, args, config.Flags{
+		"table.port":         "port",
+		"table.player_addr":  "player-addr",
+		"table.metrics_port": "metrics-port",
+		"tracing.exporter":   "tracing",
+		"tracing.endpoint":   "otlp-endpoint",
+		"log.format":         "log-format",
+		"log.level":          "log-level",
+		"log.levels":         "log-levels",
+		"shutdown_timeout":   "shutdown-timeout",
+	})
+	if err != nil {
+		return cfg, err
+	}
+	// The Table has no terminal front-end.
+	cfg.UI = config.UINone
+	return cfg, cfg.Validate()
+}
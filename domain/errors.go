@@ -0,0 +1,16 @@
+package domain
+
+import (
+	"errors"
+)
+
+var (
+	ErrMatchNotFound      = errors.New("match not found")
+	ErrScheduleNotFound   = errors.New("schedule not found")
+	ErrStorageUnavailable = errors.New("storage unavailable")
+	ErrInvalidArgument    = errors.New("invalid argument")
+	ErrNoActiveMatch      = errors.New("no match in progress")
+	ErrMatchInProgress    = errors.New("a match is already in progress")
+	ErrNoShotPending      = errors.New("no shot pending")
+	ErrShuttingDown       = errors.New("shutting down")
+)
@@ -0,0 +1,183 @@
+package domain
+
+import (
+	"sort"
+	"time"
+)
+
+type EventType string
+
+const (
+	EventMatchStarted  EventType = "MatchStarted"
+	EventBallServed    EventType = "BallServed"
+	EventBallHit       EventType = "BallHit"
+	EventPointAwarded  EventType = "PointAwarded"
+	EventMatchPaused   EventType = "MatchPaused"
+	EventMatchResumed  EventType = "MatchResumed"
+	EventMatchFinished EventType = "MatchFinished"
+)
+
+const (
+	ReasonWeakReturn = "weak_return"
+	ReasonTurnLimit  = "turn_limit"
+	ReasonRecovery   = "recovery"
+	ReasonMissed     = "missed"
+	ReasonOut        = "out"
+	ReasonShutdown   = "shutdown"
+	ReasonTimeout    = "timeout"
+)
+
+// Event is one entry in a match's append-only history. Sequence numbers start
+// at 1 for every match and are assigned by the event store.
+type Event struct {
+	ID       int          `json:"id"`
+	MatchID  int          `json:"match_id"`
+	Sequence int          `json:"sequence"`
+	Type     EventType    `json:"type"`
+	Time     time.Time    `json:"time"`
+	Payload  EventPayload `json:"payload"`
+}
+
+// EventPayload holds the fields used by the different event types; each type
+// only sets the ones it needs.
+type EventPayload struct {
+	MatchNumber int    `json:"match_number,omitempty"`
+	RoutineID   string `json:"routine_id,omitempty"`
+	TurnNumber  int    `json:"turn_number,omitempty"`
+	Player      string `json:"player,omitempty"`
+	BallPower   int    `json:"ball_power,omitempty"`
+	ReturnPower int    `json:"return_power,omitempty"`
+	Winner      string `json:"winner,omitempty"`
+	Reason      string `json:"reason,omitempty"`
+	// Rules are snapshotted by MatchStarted.
+	Rules *GameRules `json:"rules,omitempty"`
+}
+
+// Turn returns the turn recorded by a BallServed or BallHit event.
+func (e Event) Turn() Turn {
+	return Turn{
+		TurnNumber:  e.Payload.TurnNumber,
+		Time:        e.Time,
+		Player:      e.Payload.Player,
+		BallPower:   e.Payload.BallPower,
+		RoutineID:   e.Payload.RoutineID,
+		MatchNumber: e.Payload.MatchNumber,
+		ReturnPower: e.Payload.ReturnPower,
+	}
+}
+
+// TurnEvent returns the BallServed or BallHit event that records turn.
+func TurnEvent(matchID int, turn Turn) Event {
+	eventType := EventBallHit
+	if turn.TurnNumber == 1 {
+		eventType = EventBallServed
+	}
+	return Event{
+		MatchID: matchID,
+		Type:    eventType,
+		Time:    turn.Time,
+		Payload: EventPayload{
+			MatchNumber: turn.MatchNumber,
+			RoutineID:   turn.RoutineID,
+			TurnNumber:  turn.TurnNumber,
+			Player:      turn.Player,
+			BallPower:   turn.BallPower,
+			ReturnPower: turn.ReturnPower,
+		},
+	}
+}
+
+func (e Event) IsTurn() bool {
+	return e.Type == EventBallServed || e.Type == EventBallHit
+}
+
+// ProjectMatch rebuilds a match from its event stream. Turns are ordered by
+// turn number, since turns restored when a match finishes are appended after
+// the events that followed them.
+func ProjectMatch(events []Event) Match {
+	match := Match{Turns: []Turn{}}
+	for _, e := range events {
+		switch {
+		case e.Type == EventMatchStarted:
+			match.ID = e.MatchID
+			match.MatchNumber = e.Payload.MatchNumber
+			match.StartTime = e.Time
+			match.Rules = e.Payload.Rules
+		case e.IsTurn():
+			match.Turns = append(match.Turns, e.Turn())
+		case e.Type == EventMatchFinished:
+			match.EndTime = e.Time
+			match.Winner = e.Payload.Winner
+		}
+	}
+	sort.SliceStable(match.Turns, func(i, j int) bool {
+		return match.Turns[i].TurnNumber < match.Turns[j].TurnNumber
+	})
+	return match
+}
+
+type PlayerStats struct {
+	Hits           int     `json:"hits"`
+	AverageReceive float64 `json:"average_receive"`
+	AverageReturn  float64 `json:"average_return"`
+	MaxReturn      int     `json:"max_return"`
+	Points         int     `json:"points"`
+}
+
+type MatchStats struct {
+	MatchID     int                     `json:"match_id"`
+	Rally       int                     `json:"rally"`
+	Duration    time.Duration           `json:"duration"`
+	Pauses      int                     `json:"pauses"`
+	Winner      string                  `json:"winner"`
+	FinishedBy  string                  `json:"finished_by"`
+	PlayerStats map[string]*PlayerStats `json:"player_stats"`
+}
+
+// ProjectStats derives per-match statistics from its event stream.
+func ProjectStats(events []Event) MatchStats {
+	stats := MatchStats{PlayerStats: map[string]*PlayerStats{}}
+	player := func(name string) *PlayerStats {
+		if stats.PlayerStats[name] == nil {
+			stats.PlayerStats[name] = &PlayerStats{}
+		}
+		return stats.PlayerStats[name]
+	}
+
+	var start time.Time
+	for _, e := range events {
+		switch {
+		case e.Type == EventMatchStarted:
+			stats.MatchID = e.MatchID
+			start = e.Time
+		case e.IsTurn():
+			stats.Rally++
+			p := player(e.Payload.Player)
+			p.AverageReceive += float64(e.Payload.BallPower)
+			p.AverageReturn += float64(e.Payload.ReturnPower)
+			p.MaxReturn = max(p.MaxReturn, e.Payload.ReturnPower)
+			p.Hits++
+		case e.Type == EventPointAwarded:
+			player(e.Payload.Player).Points++
+			stats.FinishedBy = e.Payload.Reason
+		case e.Type == EventMatchPaused:
+			stats.Pauses++
+		case e.Type == EventMatchFinished:
+			stats.Winner = e.Payload.Winner
+			if stats.FinishedBy == "" {
+				stats.FinishedBy = e.Payload.Reason
+			}
+			if !start.IsZero() {
+				stats.Duration = e.Time.Sub(start)
+			}
+		}
+	}
+
+	for _, p := range stats.PlayerStats {
+		if p.Hits > 0 {
+			p.AverageReceive /= float64(p.Hits)
+			p.AverageReturn /= float64(p.Hits)
+		}
+	}
+	return stats
+}
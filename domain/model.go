@@ -4,6 +4,8 @@ import (
 	"time"
 )
 
+const WinnerAborted = "Aborted"
+
 type Match struct {
 	ID          int       `json:"id"`
 	MatchNumber int       `json:"match_number"`
@@ -11,6 +13,9 @@ type Match struct {
 	EndTime     time.Time `json:"end_time"`
 	Winner      string    `json:"winner"`
 	Turns       []Turn    `json:"turns"`
+	// Rules are the game rules the match was played under, or nil for matches
+	// recorded before rules were stored with them.
+	Rules *GameRules `json:"rules,omitempty"`
 }
 
 type Turn struct {
@@ -21,4 +26,51 @@ type Turn struct {
 	BallPower   int       `json:"ball_power"`
 	RoutineID   string    `json:"routine_id"`
 	MatchNumber int       `json:"match_number"`
+	// ReturnPower is the power the player sent the ball back with. It is only
+	// known for turns rebuilt from events, and 0 for a missed ball.
+	ReturnPower int `json:"return_power,omitempty"`
+}
+
+// MatchFilter selects matches by start time and by a player who hit the ball
+// at least once, keeping only the Limit most recent ones when Limit is set.
+// Zero values mean "no restriction".
+type MatchFilter struct {
+	From   time.Time
+	To     time.Time
+	Player string
+	Limit  int
+}
+
+type PlayerSummary struct {
+	Player           string  `json:"player"`
+	Matches          int     `json:"matches"`
+	Wins             int     `json:"wins"`
+	Losses           int     `json:"losses"`
+	Draws            int     `json:"draws"`
+	Hits             int     `json:"hits"`
+	AverageBallPower float64 `json:"average_ball_power"`
+}
+
+type DBStatus struct {
+	Latency       time.Duration `json:"latency"`
+	SchemaVersion int           `json:"schema_version"`
+}
+
+type DeadLetter struct {
+	ID        int       `json:"id"`
+	URL       string    `json:"url"`
+	Payload   []byte    `json:"payload"`
+	Attempts  int       `json:"attempts"`
+	LastError string    `json:"last_error"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// Schedule starts Matches matches back to back each time its cron expression
+// fires, e.g. "0 2 * * *" or "@every 1h".
+type Schedule struct {
+	ID        int       `json:"id"`
+	Name      string    `json:"name"`
+	Cron      string    `json:"cron"`
+	Matches   int       `json:"matches"`
+	CreatedAt time.Time `json:"created_at"`
 }
@@ -0,0 +1,60 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+)
+
+// PowerRange is an inclusive range of ball powers.
+type PowerRange struct {
+	Min int `yaml:"min" json:"min"`
+	Max int `yaml:"max" json:"max"`
+}
+
+// Pick maps any non-negative n onto the range.
+func (r PowerRange) Pick(n int64) int {
+	return r.Min + int(n%int64(r.Max-r.Min+1))
+}
+
+func (r PowerRange) validate(name string, limit int) error {
+	if r.Min < 0 || r.Max > limit || r.Min > r.Max {
+		return fmt.Errorf("%s must satisfy 0 <= min <= max <= %d, got %d-%d", name, limit, r.Min, r.Max)
+	}
+	return nil
+}
+
+// GameRules are the numbers the bots play by.
+type GameRules struct {
+	// Once Player B has received the ball more than TurnLimit times overall,
+	// the rally ends and the harder hit wins.
+	TurnLimit int `yaml:"turn_limit" json:"turn_limit"`
+	// Power of the Table's serve.
+	ServePower PowerRange `yaml:"serve_power" json:"serve_power"`
+	// Player A returns this percentage of the power it received.
+	ReturnPercentA PowerRange `yaml:"return_percent_a" json:"return_percent_a"`
+	// Player B returns with a power in this range, and wins the point only
+	// when it beats the power it received.
+	ReturnPowerB PowerRange `yaml:"return_power_b" json:"return_power_b"`
+}
+
+const DefaultTurnLimit = 10
+
+func DefaultGameRules() GameRules {
+	return GameRules{
+		TurnLimit:      DefaultTurnLimit,
+		ServePower:     PowerRange{Min: 70, Max: 99},
+		ReturnPercentA: PowerRange{Min: 70, Max: 89},
+		ReturnPowerB:   PowerRange{Min: 50, Max: 99},
+	}
+}
+
+func (r GameRules) Validate() error {
+	if r.TurnLimit < 1 {
+		return errors.New("turn_limit must be at least 1")
+	}
+	return errors.Join(
+		r.ServePower.validate("serve_power", MaxBallPower),
+		r.ReturnPercentA.validate("return_percent_a", 100),
+		r.ReturnPowerB.validate("return_power_b", MaxBallPower),
+	)
+}
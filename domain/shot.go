@@ -0,0 +1,48 @@
+package domain
+
+type ShotPower int
+
+const (
+	ShotSoft ShotPower = iota + 1
+	ShotMedium
+	ShotHard
+)
+
+type ShotPlacement int
+
+const (
+	PlacementCenter ShotPlacement = iota
+	PlacementLeft
+	PlacementRight
+)
+
+const (
+	// MaxBallPower is the hardest a ball can be hit and stay on the table.
+	MaxBallPower = 100
+
+	// A wide shot is harder to return but easier to hit long.
+	wideShotBonus = 10
+	// A late swing loses some of the shot's power.
+	lateShotPenalty = 10
+)
+
+// Shot is a human player's answer to an incoming ball.
+type Shot struct {
+	Power     ShotPower
+	Placement ShotPlacement
+	Late      bool
+}
+
+// ReturnPower is the power of the ball sent back after a shot at a ball that
+// arrived with the given power. Above MaxBallPower the ball goes long.
+func (s Shot) ReturnPower(received int) int {
+	percent := map[ShotPower]int{ShotSoft: 60, ShotMedium: 80, ShotHard: 100}[s.Power]
+	power := received * percent / 100
+	if s.Placement != PlacementCenter {
+		power += wideShotBonus
+	}
+	if s.Late {
+		power -= lateShotPenalty
+	}
+	return max(power, 0)
+}
@@ -0,0 +1,190 @@
+// Package logging is the structured, leveled logging of the pingpong
+// services, built on log/slog. Every component logs through its own logger,
+// whose level can be set apart from the others, and lines logged with the
+// context of a match carry the match's IDs, turn and player.
+package logging
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"strings"
+	"sync"
+	"sync/atomic"
+)
+
+// Output formats selectable with Setup.
+const (
+	FormatText = "text"
+	FormatJSON = "json"
+)
+
+// Components that log, for setting their levels.
+const (
+	ComponentApp       = "app"
+	ComponentPlayer    = "player"
+	ComponentTable     = "table"
+	ComponentService   = "service"
+	ComponentScheduler = "scheduler"
+	ComponentMySQL     = "mysql"
+	ComponentWebhook   = "webhook"
+	ComponentNATS      = "nats"
+	ComponentREST      = "rest"
+)
+
+var Components = []string{
+	ComponentApp, ComponentPlayer, ComponentTable, ComponentService, ComponentScheduler,
+	ComponentMySQL, ComponentWebhook, ComponentNATS, ComponentREST,
+}
+
+type Options struct {
+	Format string
+	Level  slog.Level
+	// Levels override Level for single components.
+	Levels map[string]slog.Level
+}
+
+// output is what component loggers write to. Setup replaces it as a whole,
+// so loggers created before it still follow it.
+type output struct {
+	handler slog.Handler
+	level   slog.Level
+	levels  map[string]slog.Level
+}
+
+func (o *output) enabled(component string, level slog.Level) bool {
+	threshold, ok := o.levels[component]
+	if !ok {
+		threshold = o.level
+	}
+	return level >= threshold
+}
+
+var (
+	current atomic.Pointer[output]
+	dest    = &switchWriter{w: os.Stderr}
+)
+
+func init() {
+	current.Store(&output{handler: slog.NewTextHandler(dest, handlerOptions)})
+}
+
+// Components filter by level themselves, so the handlers let everything
+// through.
+var handlerOptions = &slog.HandlerOptions{Level: slog.LevelDebug}
+
+// Setup sends the lines of every component, and of the log and slog
+// packages, to the current output in the given format.
+func Setup(opts Options) error {
+	var handler slog.Handler
+	switch opts.Format {
+	case FormatText:
+		handler = slog.NewTextHandler(dest, handlerOptions)
+	case FormatJSON:
+		handler = slog.NewJSONHandler(dest, handlerOptions)
+	default:
+		return fmt.Errorf("unknown log format %q (expected %q or %q)", opts.Format, FormatText, FormatJSON)
+	}
+
+	current.Store(&output{handler: handler, level: opts.Level, levels: opts.Levels})
+	slog.SetDefault(slog.New(&componentHandler{}))
+	return nil
+}
+
+// SetOutput redirects log lines, such as into a panel while a terminal UI
+// runs.
+func SetOutput(w io.Writer) {
+	dest.set(w)
+}
+
+// For returns the logger of component.
+func For(component string) *slog.Logger {
+	return slog.New(&componentHandler{component: component})
+}
+
+// ParseLevels parses the level names of components, such as "debug" for
+// "mysql", and rejects components that do not exist.
+func ParseLevels(spec map[string]string) (map[string]slog.Level, error) {
+	levels := make(map[string]slog.Level, len(spec))
+	for component, name := range spec {
+		if !isComponent(component) {
+			return nil, fmt.Errorf("unknown component %q (expected one of %s)", component, strings.Join(Components, ", "))
+		}
+		var level slog.Level
+		if err := level.UnmarshalText([]byte(name)); err != nil {
+			return nil, fmt.Errorf("%s: %w", component, err)
+		}
+		levels[component] = level
+	}
+	return levels, nil
+}
+
+func isComponent(name string) bool {
+	for _, c := range Components {
+		if c == name {
+			return true
+		}
+	}
+	return false
+}
+
+// componentHandler tags lines with their component, drops those below the
+// component's level and adds the match attributes found in the context.
+// Attributes and groups added to a logger are replayed onto the current
+// output for each line, so they survive Setup.
+type componentHandler struct {
+	component string
+	with      []func(slog.Handler) slog.Handler
+}
+
+func (h *componentHandler) Enabled(_ context.Context, level slog.Level) bool {
+	return current.Load().enabled(h.component, level)
+}
+
+func (h *componentHandler) Handle(ctx context.Context, r slog.Record) error {
+	handler := current.Load().handler
+	if h.component != "" {
+		handler = handler.WithAttrs([]slog.Attr{slog.String("component", h.component)})
+	}
+	for _, with := range h.with {
+		handler = with(handler)
+	}
+	r.AddAttrs(matchAttrs(ctx)...)
+	return handler.Handle(ctx, r)
+}
+
+func (h *componentHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return h.extend(func(handler slog.Handler) slog.Handler { return handler.WithAttrs(attrs) })
+}
+
+func (h *componentHandler) WithGroup(name string) slog.Handler {
+	return h.extend(func(handler slog.Handler) slog.Handler { return handler.WithGroup(name) })
+}
+
+func (h *componentHandler) extend(with func(slog.Handler) slog.Handler) *componentHandler {
+	return &componentHandler{
+		component: h.component,
+		with:      append(h.with[:len(h.with):len(h.with)], with),
+	}
+}
+
+// switchWriter is an io.Writer whose destination can change while lines are
+// written to it.
+type switchWriter struct {
+	mu sync.Mutex
+	w  io.Writer
+}
+
+func (s *switchWriter) Write(p []byte) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.w.Write(p)
+}
+
+func (s *switchWriter) set(w io.Writer) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.w = w
+}
@@ -0,0 +1,111 @@
+package logging
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"log/slog"
+	"os"
+	"strings"
+	"testing"
+)
+
+// capture sends the output of every component to a buffer, as JSON lines,
+// until the test ends.
+func capture(t *testing.T, opts Options) *bytes.Buffer {
+	t.Helper()
+	var buf bytes.Buffer
+	SetOutput(&buf)
+	opts.Format = FormatJSON
+	if err := Setup(opts); err != nil {
+		t.Fatalf("Setup: %v", err)
+	}
+	t.Cleanup(func() {
+		SetOutput(os.Stderr)
+		Setup(Options{Format: FormatText})
+	})
+	return &buf
+}
+
+func lines(t *testing.T, buf *bytes.Buffer) []map[string]any {
+	t.Helper()
+	var out []map[string]any
+	for _, line := range strings.Split(strings.TrimSpace(buf.String()), "\n") {
+		if line == "" {
+			continue
+		}
+		var entry map[string]any
+		if err := json.Unmarshal([]byte(line), &entry); err != nil {
+			t.Fatalf("line %q is not JSON: %v", line, err)
+		}
+		out = append(out, entry)
+	}
+	return out
+}
+
+func TestParseLevels(t *testing.T) {
+	levels, err := ParseLevels(map[string]string{"mysql": "debug", "table": "WARN"})
+	if err != nil {
+		t.Fatalf("ParseLevels: %v", err)
+	}
+	if levels[ComponentMySQL] != slog.LevelDebug || levels[ComponentTable] != slog.LevelWarn {
+		t.Errorf("got %v, want mysql=DEBUG and table=WARN", levels)
+	}
+
+	for _, spec := range []map[string]string{
+		{"database": "debug"},
+		{"mysql": "loud"},
+	} {
+		if _, err := ParseLevels(spec); err == nil {
+			t.Errorf("ParseLevels(%v) succeeded, want an error", spec)
+		}
+	}
+}
+
+func TestComponentLevels(t *testing.T) {
+	buf := capture(t, Options{
+		Level:  slog.LevelInfo,
+		Levels: map[string]slog.Level{ComponentMySQL: slog.LevelDebug, ComponentTable: slog.LevelWarn},
+	})
+
+	For(ComponentMySQL).Debug("mysql debug")
+	For(ComponentTable).Info("table info")
+	For(ComponentTable).Warn("table warn")
+	For(ComponentPlayer).Debug("player debug")
+	For(ComponentPlayer).Info("player info")
+
+	var got []string
+	for _, entry := range lines(t, buf) {
+		got = append(got, entry["component"].(string)+": "+entry["msg"].(string))
+	}
+	want := []string{"mysql: mysql debug", "table: table warn", "player: player info"}
+	if strings.Join(got, "\n") != strings.Join(want, "\n") {
+		t.Errorf("logged\n%s\nwant\n%s", strings.Join(got, "\n"), strings.Join(want, "\n"))
+	}
+}
+
+func TestWithMatchAttributes(t *testing.T) {
+	buf := capture(t, Options{Level: slog.LevelInfo})
+
+	ctx := WithMatch(context.Background(), 42, "match-7-20261019", 3)
+	ctx = WithTurn(ctx, 4, "B")
+	For(ComponentPlayer).InfoContext(ctx, "ball hit")
+	For(ComponentPlayer).InfoContext(WithMatch(context.Background(), 0, "match-8", 0), "not persisted")
+
+	entries := lines(t, buf)
+	if len(entries) != 2 {
+		t.Fatalf("logged %d lines, want 2", len(entries))
+	}
+	want := map[string]string{"match_id": "42", "routine_id": "match-7-20261019", "turn": "4", "player": "B"}
+	for key, value := range want {
+		if entries[0][key] != value {
+			t.Errorf("%s = %v, want %q", key, entries[0][key], value)
+		}
+	}
+	if _, ok := entries[1]["match_id"]; ok {
+		t.Errorf("match without an ID logged match_id %v", entries[1]["match_id"])
+	}
+	if entries[1]["routine_id"] != "match-8" {
+		t.Errorf("routine_id = %v, want match-8", entries[1]["routine_id"])
+	}
+}
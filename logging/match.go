@@ -0,0 +1,74 @@
+package logging
+
+import (
+	"context"
+	"log/slog"
+	"strconv"
+
+	"go.opentelemetry.io/otel/baggage"
+)
+
+// Match attributes travel as OpenTelemetry baggage, so they reach the peer
+// service along with the trace context of every gRPC call made with ctx.
+const (
+	keyMatchID   = "pingpong.match_id"
+	keyRoutineID = "pingpong.routine_id"
+	keyTurn      = "pingpong.turn"
+	keyPlayer    = "pingpong.player"
+)
+
+var matchKeys = []struct{ baggage, attr string }{
+	{keyMatchID, "match_id"},
+	{keyRoutineID, "routine_id"},
+	{keyTurn, "turn"},
+	{keyPlayer, "player"},
+}
+
+// WithMatch returns ctx with the match, and the turn it is at, that lines
+// logged with it belong to. A zero matchID, of a match not persisted yet, is
+// left out; the routine ID still tells such matches apart.
+func WithMatch(ctx context.Context, matchID int, routineID string, turn int) context.Context {
+	ctx = withMembers(ctx, keyRoutineID, routineID, keyTurn, strconv.Itoa(turn))
+	if matchID == 0 {
+		return ctx
+	}
+	return withMembers(ctx, keyMatchID, strconv.Itoa(matchID))
+}
+
+// WithTurn returns ctx with the turn, and the player hitting the ball in it,
+// that lines logged with it belong to.
+func WithTurn(ctx context.Context, turn int, player string) context.Context {
+	return withMembers(ctx, keyTurn, strconv.Itoa(turn), keyPlayer, player)
+}
+
+func withMembers(ctx context.Context, pairs ...string) context.Context {
+	bag := baggage.FromContext(ctx)
+	for i := 0; i < len(pairs); i += 2 {
+		member, err := baggage.NewMemberRaw(pairs[i], pairs[i+1])
+		if err != nil {
+			continue
+		}
+		if next, err := bag.SetMember(member); err == nil {
+			bag = next
+		}
+	}
+	return baggage.ContextWithBaggage(ctx, bag)
+}
+
+func matchAttrs(ctx context.Context) []slog.Attr {
+	if ctx == nil {
+		return nil
+	}
+	bag := baggage.FromContext(ctx)
+	if bag.Len() == 0 {
+		return nil
+	}
+
+	var attrs []slog.Attr
+	for _, key := range matchKeys {
+		if member := bag.Member(key.baggage); member.Key() != "" {
+			attrs = append(attrs, slog.String(key.attr, member.Value()))
+		}
+	}
+	return attrs
+}
@@ -0,0 +1,12 @@
+package ports
+
+import (
+	"pingpong/domain"
+)
+
+// MatchMetrics is told what happens in matches so it can be monitored.
+type MatchMetrics interface {
+	MatchStarted()
+	BallHit(player string, power int)
+	MatchFinished(match domain.Match, reason string)
+}
@@ -0,0 +1,17 @@
+package ports
+
+import (
+	"context"
+
+	"pingpong/domain"
+)
+
+// MatchNotifier is told about every match once its winner is decided.
+type MatchNotifier interface {
+	NotifyMatchFinished(ctx context.Context, match domain.Match) error
+}
+
+// DeadLetterStore keeps notifications that could not be delivered.
+type DeadLetterStore interface {
+	SaveDeadLetter(ctx context.Context, letter domain.DeadLetter) error
+}
@@ -0,0 +1,13 @@
+package ports
+
+import (
+	"context"
+
+	"pingpong/domain"
+)
+
+// EventPublisher announces recorded match events to other services.
+type EventPublisher interface {
+	Publish(ctx context.Context, event domain.Event) error
+	Close() error
+}
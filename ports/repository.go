@@ -2,13 +2,29 @@ package ports
 
 import (
 	"context"
+	"time"
 
 	"pingpong/domain"
 )
 
 type MatchRepository interface {
 	SaveMatch(ctx context.Context, match domain.Match) error
+	CreateMatch(ctx context.Context, match domain.Match) (int, error)
+	AppendTurn(ctx context.Context, matchID int, turn domain.Turn) error
+	FinishMatch(ctx context.Context, matchID int, endTime time.Time, winner string) error
+	AppendEvent(ctx context.Context, event domain.Event) (domain.Event, error)
+	ListEvents(ctx context.Context, matchID int) ([]domain.Event, error)
 	GetMatchByID(ctx context.Context, id int) (domain.Match, error)
 	GetLastMatch(ctx context.Context) (domain.Match, error)
+	StreamMatches(ctx context.Context, filter domain.MatchFilter, fn func(domain.Match) error) error
+	MatchExistsByRoutineID(ctx context.Context, routineID string) (bool, error)
+	GetPlayerStats(ctx context.Context, player string) (domain.PlayerSummary, error)
+	GetMaxMatchNumber(ctx context.Context) (int, error)
+	GetUnfinishedMatches(ctx context.Context) ([]domain.Match, error)
 	TestConnection(ctx context.Context) error
-}
\ No newline at end of file
+	CheckTables(ctx context.Context) error
+	SchemaVersion(ctx context.Context) (int, error)
+	// Transaction runs fn with a repository whose writes are committed
+	// together when fn returns nil and rolled back otherwise.
+	Transaction(ctx context.Context, fn func(repo MatchRepository) error) error
+}
@@ -0,0 +1,26 @@
+package ports
+
+import (
+	"context"
+
+	"pingpong/domain"
+)
+
+// ScheduleStore keeps the schedules matches are started on.
+type ScheduleStore interface {
+	CreateSchedule(ctx context.Context, schedule domain.Schedule) (domain.Schedule, error)
+	ListSchedules(ctx context.Context) ([]domain.Schedule, error)
+	DeleteSchedule(ctx context.Context, id int) error
+}
+
+// ScheduleService manages schedules and runs them.
+type ScheduleService interface {
+	CreateSchedule(ctx context.Context, schedule domain.Schedule) (domain.Schedule, error)
+	ListSchedules(ctx context.Context) ([]domain.Schedule, error)
+	DeleteSchedule(ctx context.Context, id int) error
+}
+
+// MatchPlayer plays one match to the end.
+type MatchPlayer interface {
+	PlayMatch(ctx context.Context) error
+}
@@ -8,7 +8,16 @@ import (
 
 type MatchService interface {
 	SaveMatch(ctx context.Context, match domain.Match) error
+	ImportMatch(ctx context.Context, match domain.Match) (bool, error)
+	RecordEvent(ctx context.Context, event domain.Event) (domain.Event, error)
+	FinishMatch(ctx context.Context, event domain.Event, turns []domain.Turn) (domain.Event, error)
 	GetMatchByID(ctx context.Context, id int) (domain.Match, error)
+	GetMatchStats(ctx context.Context, id int) (domain.MatchStats, error)
 	GetLastMatch(ctx context.Context) (domain.Match, error)
-	TestConnection(ctx context.Context) error
-}
\ No newline at end of file
+	StreamMatches(ctx context.Context, filter domain.MatchFilter, fn func(domain.Match) error) error
+	GetPlayerStats(ctx context.Context, player string) (domain.PlayerSummary, error)
+	GetMaxMatchNumber(ctx context.Context) (int, error)
+	GetUnfinishedMatches(ctx context.Context) ([]domain.Match, error)
+	TestConnection(ctx context.Context) (domain.DBStatus, error)
+	CheckTables(ctx context.Context) error
+}
@@ -0,0 +1,13 @@
+package ports
+
+import (
+	"pingpong/domain"
+)
+
+// TurnSink receives every turn as it is played. Writes may be buffered until
+// Flush, which the server calls when a match ends.
+type TurnSink interface {
+	WriteTurn(turn domain.Turn) error
+	Flush() error
+	Close() error
+}
@@ -0,0 +1,11 @@
+package proto
+
+import (
+	_ "embed"
+)
+
+// OpenAPI is the Swagger 2.0 document for the REST routes declared with
+// google.api.http options in pingpong.proto. Regenerate it with `make proto`.
+//
+//go:embed pingpong.swagger.json
+var OpenAPI []byte
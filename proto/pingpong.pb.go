@@ -9,8 +9,10 @@
 package proto
 
 import (
+	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
@@ -25,6 +27,218 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type ExportFormat int32
+
+const (
+	ExportFormat_EXPORT_FORMAT_JSONL   ExportFormat = 0
+	ExportFormat_EXPORT_FORMAT_CSV     ExportFormat = 1
+	ExportFormat_EXPORT_FORMAT_PARQUET ExportFormat = 2
+)
+
+// Enum value maps for ExportFormat.
+var (
+	ExportFormat_name = map[int32]string{
+		0: "EXPORT_FORMAT_JSONL",
+		1: "EXPORT_FORMAT_CSV",
+		2: "EXPORT_FORMAT_PARQUET",
+	}
+	ExportFormat_value = map[string]int32{
+		"EXPORT_FORMAT_JSONL":   0,
+		"EXPORT_FORMAT_CSV":     1,
+		"EXPORT_FORMAT_PARQUET": 2,
+	}
+)
+
+func (x ExportFormat) Enum() *ExportFormat {
+	p := new(ExportFormat)
+	*p = x
+	return p
+}
+
+func (x ExportFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ExportFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_pingpong_proto_enumTypes[0].Descriptor()
+}
+
+func (ExportFormat) Type() protoreflect.EnumType {
+	return &file_pingpong_proto_enumTypes[0]
+}
+
+func (x ExportFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ExportFormat.Descriptor instead.
+func (ExportFormat) EnumDescriptor() ([]byte, []int) {
+	return file_pingpong_proto_rawDescGZIP(), []int{0}
+}
+
+type ShotPower int32
+
+const (
+	ShotPower_SHOT_POWER_UNSPECIFIED ShotPower = 0
+	ShotPower_SHOT_POWER_SOFT        ShotPower = 1
+	ShotPower_SHOT_POWER_MEDIUM      ShotPower = 2
+	ShotPower_SHOT_POWER_HARD        ShotPower = 3
+)
+
+// Enum value maps for ShotPower.
+var (
+	ShotPower_name = map[int32]string{
+		0: "SHOT_POWER_UNSPECIFIED",
+		1: "SHOT_POWER_SOFT",
+		2: "SHOT_POWER_MEDIUM",
+		3: "SHOT_POWER_HARD",
+	}
+	ShotPower_value = map[string]int32{
+		"SHOT_POWER_UNSPECIFIED": 0,
+		"SHOT_POWER_SOFT":        1,
+		"SHOT_POWER_MEDIUM":      2,
+		"SHOT_POWER_HARD":        3,
+	}
+)
+
+func (x ShotPower) Enum() *ShotPower {
+	p := new(ShotPower)
+	*p = x
+	return p
+}
+
+func (x ShotPower) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ShotPower) Descriptor() protoreflect.EnumDescriptor {
+	return file_pingpong_proto_enumTypes[1].Descriptor()
+}
+
+func (ShotPower) Type() protoreflect.EnumType {
+	return &file_pingpong_proto_enumTypes[1]
+}
+
+func (x ShotPower) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ShotPower.Descriptor instead.
+func (ShotPower) EnumDescriptor() ([]byte, []int) {
+	return file_pingpong_proto_rawDescGZIP(), []int{1}
+}
+
+type ShotPlacement int32
+
+const (
+	ShotPlacement_SHOT_PLACEMENT_CENTER ShotPlacement = 0
+	ShotPlacement_SHOT_PLACEMENT_LEFT   ShotPlacement = 1
+	ShotPlacement_SHOT_PLACEMENT_RIGHT  ShotPlacement = 2
+)
+
+// Enum value maps for ShotPlacement.
+var (
+	ShotPlacement_name = map[int32]string{
+		0: "SHOT_PLACEMENT_CENTER",
+		1: "SHOT_PLACEMENT_LEFT",
+		2: "SHOT_PLACEMENT_RIGHT",
+	}
+	ShotPlacement_value = map[string]int32{
+		"SHOT_PLACEMENT_CENTER": 0,
+		"SHOT_PLACEMENT_LEFT":   1,
+		"SHOT_PLACEMENT_RIGHT":  2,
+	}
+)
+
+func (x ShotPlacement) Enum() *ShotPlacement {
+	p := new(ShotPlacement)
+	*p = x
+	return p
+}
+
+func (x ShotPlacement) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ShotPlacement) Descriptor() protoreflect.EnumDescriptor {
+	return file_pingpong_proto_enumTypes[2].Descriptor()
+}
+
+func (ShotPlacement) Type() protoreflect.EnumType {
+	return &file_pingpong_proto_enumTypes[2]
+}
+
+func (x ShotPlacement) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ShotPlacement.Descriptor instead.
+func (ShotPlacement) EnumDescriptor() ([]byte, []int) {
+	return file_pingpong_proto_rawDescGZIP(), []int{2}
+}
+
+type MatchUpdate_Kind int32
+
+const (
+	MatchUpdate_KIND_UNSPECIFIED MatchUpdate_Kind = 0
+	MatchUpdate_MATCH_STARTED    MatchUpdate_Kind = 1
+	MatchUpdate_TURN             MatchUpdate_Kind = 2
+	MatchUpdate_MATCH_FINISHED   MatchUpdate_Kind = 3
+	MatchUpdate_PAUSED           MatchUpdate_Kind = 4
+	MatchUpdate_RESUMED          MatchUpdate_Kind = 5
+	// A human player has to hit the ball in turn before deadline.
+	MatchUpdate_SHOT_REQUESTED MatchUpdate_Kind = 6
+)
+
+// Enum value maps for MatchUpdate_Kind.
+var (
+	MatchUpdate_Kind_name = map[int32]string{
+		0: "KIND_UNSPECIFIED",
+		1: "MATCH_STARTED",
+		2: "TURN",
+		3: "MATCH_FINISHED",
+		4: "PAUSED",
+		5: "RESUMED",
+		6: "SHOT_REQUESTED",
+	}
+	MatchUpdate_Kind_value = map[string]int32{
+		"KIND_UNSPECIFIED": 0,
+		"MATCH_STARTED":    1,
+		"TURN":             2,
+		"MATCH_FINISHED":   3,
+		"PAUSED":           4,
+		"RESUMED":          5,
+		"SHOT_REQUESTED":   6,
+	}
+)
+
+func (x MatchUpdate_Kind) Enum() *MatchUpdate_Kind {
+	p := new(MatchUpdate_Kind)
+	*p = x
+	return p
+}
+
+func (x MatchUpdate_Kind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MatchUpdate_Kind) Descriptor() protoreflect.EnumDescriptor {
+	return file_pingpong_proto_enumTypes[3].Descriptor()
+}
+
+func (MatchUpdate_Kind) Type() protoreflect.EnumType {
+	return &file_pingpong_proto_enumTypes[3]
+}
+
+func (x MatchUpdate_Kind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MatchUpdate_Kind.Descriptor instead.
+func (MatchUpdate_Kind) EnumDescriptor() ([]byte, []int) {
+	return file_pingpong_proto_rawDescGZIP(), []int{22, 0}
+}
+
 type IsGameActiveResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Active        bool                   `protobuf:"varint,1,opt,name=active,proto3" json:"active,omitempty"`
@@ -309,26 +523,31 @@ func (x *GetMatchByIDRequest) GetId() int32 {
 	return 0
 }
 
-type TestDBRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
+type ListMatchesRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	From   *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	Player string                 `protobuf:"bytes,3,opt,name=player,proto3" json:"player,omitempty"`
+	// Most recent matches to return; 0 uses the server default.
+	Limit         int32 `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *TestDBRequest) Reset() {
-	*x = TestDBRequest{}
+func (x *ListMatchesRequest) Reset() {
+	*x = ListMatchesRequest{}
 	mi := &file_pingpong_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TestDBRequest) String() string {
+func (x *ListMatchesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TestDBRequest) ProtoMessage() {}
+func (*ListMatchesRequest) ProtoMessage() {}
 
-func (x *TestDBRequest) ProtoReflect() protoreflect.Message {
+func (x *ListMatchesRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_pingpong_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -340,32 +559,60 @@ func (x *TestDBRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TestDBRequest.ProtoReflect.Descriptor instead.
-func (*TestDBRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListMatchesRequest.ProtoReflect.Descriptor instead.
+func (*ListMatchesRequest) Descriptor() ([]byte, []int) {
 	return file_pingpong_proto_rawDescGZIP(), []int{7}
 }
 
-type TestDBResponse struct {
+func (x *ListMatchesRequest) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *ListMatchesRequest) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *ListMatchesRequest) GetPlayer() string {
+	if x != nil {
+		return x.Player
+	}
+	return ""
+}
+
+func (x *ListMatchesRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListMatchesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Matches       []*Match               `protobuf:"bytes,1,rep,name=matches,proto3" json:"matches,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *TestDBResponse) Reset() {
-	*x = TestDBResponse{}
+func (x *ListMatchesResponse) Reset() {
+	*x = ListMatchesResponse{}
 	mi := &file_pingpong_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TestDBResponse) String() string {
+func (x *ListMatchesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TestDBResponse) ProtoMessage() {}
+func (*ListMatchesResponse) ProtoMessage() {}
 
-func (x *TestDBResponse) ProtoReflect() protoreflect.Message {
+func (x *ListMatchesResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_pingpong_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -377,38 +624,39 @@ func (x *TestDBResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TestDBResponse.ProtoReflect.Descriptor instead.
-func (*TestDBResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListMatchesResponse.ProtoReflect.Descriptor instead.
+func (*ListMatchesResponse) Descriptor() ([]byte, []int) {
 	return file_pingpong_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *TestDBResponse) GetMessage() string {
+func (x *ListMatchesResponse) GetMatches() []*Match {
 	if x != nil {
-		return x.Message
+		return x.Matches
 	}
-	return ""
+	return nil
 }
 
-type StartGameRequest struct {
+type SaveMatchResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *StartGameRequest) Reset() {
-	*x = StartGameRequest{}
+func (x *SaveMatchResponse) Reset() {
+	*x = SaveMatchResponse{}
 	mi := &file_pingpong_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *StartGameRequest) String() string {
+func (x *SaveMatchResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StartGameRequest) ProtoMessage() {}
+func (*SaveMatchResponse) ProtoMessage() {}
 
-func (x *StartGameRequest) ProtoReflect() protoreflect.Message {
+func (x *SaveMatchResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_pingpong_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -420,32 +668,39 @@ func (x *StartGameRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StartGameRequest.ProtoReflect.Descriptor instead.
-func (*StartGameRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SaveMatchResponse.ProtoReflect.Descriptor instead.
+func (*SaveMatchResponse) Descriptor() ([]byte, []int) {
 	return file_pingpong_proto_rawDescGZIP(), []int{9}
 }
 
-type StartGameResponse struct {
+func (x *SaveMatchResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type GetPlayerStatsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	PlayerId      string                 `protobuf:"bytes,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *StartGameResponse) Reset() {
-	*x = StartGameResponse{}
+func (x *GetPlayerStatsRequest) Reset() {
+	*x = GetPlayerStatsRequest{}
 	mi := &file_pingpong_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *StartGameResponse) String() string {
+func (x *GetPlayerStatsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StartGameResponse) ProtoMessage() {}
+func (*GetPlayerStatsRequest) ProtoMessage() {}
 
-func (x *StartGameResponse) ProtoReflect() protoreflect.Message {
+func (x *GetPlayerStatsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_pingpong_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -457,40 +712,45 @@ func (x *StartGameResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StartGameResponse.ProtoReflect.Descriptor instead.
-func (*StartGameResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetPlayerStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetPlayerStatsRequest) Descriptor() ([]byte, []int) {
 	return file_pingpong_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *StartGameResponse) GetMessage() string {
+func (x *GetPlayerStatsRequest) GetPlayerId() string {
 	if x != nil {
-		return x.Message
+		return x.PlayerId
 	}
 	return ""
 }
 
-type ReceiveBallRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	BallPower     int32                  `protobuf:"varint,1,opt,name=ball_power,json=ballPower,proto3" json:"ball_power,omitempty"`
-	FromPlayer    string                 `protobuf:"bytes,2,opt,name=from_player,json=fromPlayer,proto3" json:"from_player,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type PlayerSummary struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	PlayerId         string                 `protobuf:"bytes,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	Matches          int32                  `protobuf:"varint,2,opt,name=matches,proto3" json:"matches,omitempty"`
+	Wins             int32                  `protobuf:"varint,3,opt,name=wins,proto3" json:"wins,omitempty"`
+	Losses           int32                  `protobuf:"varint,4,opt,name=losses,proto3" json:"losses,omitempty"`
+	Draws            int32                  `protobuf:"varint,5,opt,name=draws,proto3" json:"draws,omitempty"`
+	Hits             int32                  `protobuf:"varint,6,opt,name=hits,proto3" json:"hits,omitempty"`
+	AverageBallPower float64                `protobuf:"fixed64,7,opt,name=average_ball_power,json=averageBallPower,proto3" json:"average_ball_power,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
-func (x *ReceiveBallRequest) Reset() {
-	*x = ReceiveBallRequest{}
+func (x *PlayerSummary) Reset() {
+	*x = PlayerSummary{}
 	mi := &file_pingpong_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ReceiveBallRequest) String() string {
+func (x *PlayerSummary) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReceiveBallRequest) ProtoMessage() {}
+func (*PlayerSummary) ProtoMessage() {}
 
-func (x *ReceiveBallRequest) ProtoReflect() protoreflect.Message {
+func (x *PlayerSummary) ProtoReflect() protoreflect.Message {
 	mi := &file_pingpong_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -502,45 +762,80 @@ func (x *ReceiveBallRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReceiveBallRequest.ProtoReflect.Descriptor instead.
-func (*ReceiveBallRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use PlayerSummary.ProtoReflect.Descriptor instead.
+func (*PlayerSummary) Descriptor() ([]byte, []int) {
 	return file_pingpong_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *ReceiveBallRequest) GetBallPower() int32 {
+func (x *PlayerSummary) GetPlayerId() string {
 	if x != nil {
-		return x.BallPower
+		return x.PlayerId
+	}
+	return ""
+}
+
+func (x *PlayerSummary) GetMatches() int32 {
+	if x != nil {
+		return x.Matches
 	}
 	return 0
 }
 
-func (x *ReceiveBallRequest) GetFromPlayer() string {
+func (x *PlayerSummary) GetWins() int32 {
 	if x != nil {
-		return x.FromPlayer
+		return x.Wins
 	}
-	return ""
+	return 0
 }
 
-type ReceiveBallResponse struct {
+func (x *PlayerSummary) GetLosses() int32 {
+	if x != nil {
+		return x.Losses
+	}
+	return 0
+}
+
+func (x *PlayerSummary) GetDraws() int32 {
+	if x != nil {
+		return x.Draws
+	}
+	return 0
+}
+
+func (x *PlayerSummary) GetHits() int32 {
+	if x != nil {
+		return x.Hits
+	}
+	return 0
+}
+
+func (x *PlayerSummary) GetAverageBallPower() float64 {
+	if x != nil {
+		return x.AverageBallPower
+	}
+	return 0
+}
+
+type TestDBRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ReceiveBallResponse) Reset() {
-	*x = ReceiveBallResponse{}
+func (x *TestDBRequest) Reset() {
+	*x = TestDBRequest{}
 	mi := &file_pingpong_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ReceiveBallResponse) String() string {
+func (x *TestDBRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReceiveBallResponse) ProtoMessage() {}
+func (*TestDBRequest) ProtoMessage() {}
 
-func (x *ReceiveBallResponse) ProtoReflect() protoreflect.Message {
+func (x *TestDBRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_pingpong_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -552,37 +847,34 @@ func (x *ReceiveBallResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReceiveBallResponse.ProtoReflect.Descriptor instead.
-func (*ReceiveBallResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use TestDBRequest.ProtoReflect.Descriptor instead.
+func (*TestDBRequest) Descriptor() ([]byte, []int) {
 	return file_pingpong_proto_rawDescGZIP(), []int{12}
 }
 
-type Match struct {
+type TestDBResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	MatchNumber   int32                  `protobuf:"varint,2,opt,name=match_number,json=matchNumber,proto3" json:"match_number,omitempty"`
-	StartTime     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
-	EndTime       *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
-	Winner        string                 `protobuf:"bytes,5,opt,name=winner,proto3" json:"winner,omitempty"`
-	Turns         []*Turn                `protobuf:"bytes,6,rep,name=turns,proto3" json:"turns,omitempty"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	LatencyMs     float64                `protobuf:"fixed64,2,opt,name=latency_ms,json=latencyMs,proto3" json:"latency_ms,omitempty"`
+	SchemaVersion int32                  `protobuf:"varint,3,opt,name=schema_version,json=schemaVersion,proto3" json:"schema_version,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Match) Reset() {
-	*x = Match{}
+func (x *TestDBResponse) Reset() {
+	*x = TestDBResponse{}
 	mi := &file_pingpong_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Match) String() string {
+func (x *TestDBResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Match) ProtoMessage() {}
+func (*TestDBResponse) ProtoMessage() {}
 
-func (x *Match) ProtoReflect() protoreflect.Message {
+func (x *TestDBResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_pingpong_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -594,53 +886,1162 @@ func (x *Match) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Match.ProtoReflect.Descriptor instead.
-func (*Match) Descriptor() ([]byte, []int) {
+// Deprecated: Use TestDBResponse.ProtoReflect.Descriptor instead.
+func (*TestDBResponse) Descriptor() ([]byte, []int) {
 	return file_pingpong_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *Match) GetId() int32 {
+func (x *TestDBResponse) GetMessage() string {
 	if x != nil {
-		return x.Id
+		return x.Message
 	}
-	return 0
+	return ""
 }
 
-func (x *Match) GetMatchNumber() int32 {
+func (x *TestDBResponse) GetLatencyMs() float64 {
 	if x != nil {
-		return x.MatchNumber
+		return x.LatencyMs
 	}
 	return 0
 }
 
-func (x *Match) GetStartTime() *timestamppb.Timestamp {
+func (x *TestDBResponse) GetSchemaVersion() int32 {
 	if x != nil {
-		return x.StartTime
+		return x.SchemaVersion
 	}
-	return nil
+	return 0
 }
 
-func (x *Match) GetEndTime() *timestamppb.Timestamp {
-	if x != nil {
-		return x.EndTime
-	}
-	return nil
+type ExportMatchesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Format        ExportFormat           `protobuf:"varint,1,opt,name=format,proto3,enum=pingpong.ExportFormat" json:"format,omitempty"`
+	From          *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	To            *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+	Player        string                 `protobuf:"bytes,4,opt,name=player,proto3" json:"player,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Match) GetWinner() string {
+func (x *ExportMatchesRequest) Reset() {
+	*x = ExportMatchesRequest{}
+	mi := &file_pingpong_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportMatchesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportMatchesRequest) ProtoMessage() {}
+
+func (x *ExportMatchesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pingpong_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportMatchesRequest.ProtoReflect.Descriptor instead.
+func (*ExportMatchesRequest) Descriptor() ([]byte, []int) {
+	return file_pingpong_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ExportMatchesRequest) GetFormat() ExportFormat {
+	if x != nil {
+		return x.Format
+	}
+	return ExportFormat_EXPORT_FORMAT_JSONL
+}
+
+func (x *ExportMatchesRequest) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *ExportMatchesRequest) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *ExportMatchesRequest) GetPlayer() string {
+	if x != nil {
+		return x.Player
+	}
+	return ""
+}
+
+type ExportChunk struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          []byte                 `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportChunk) Reset() {
+	*x = ExportChunk{}
+	mi := &file_pingpong_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportChunk) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportChunk) ProtoMessage() {}
+
+func (x *ExportChunk) ProtoReflect() protoreflect.Message {
+	mi := &file_pingpong_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportChunk.ProtoReflect.Descriptor instead.
+func (*ExportChunk) Descriptor() ([]byte, []int) {
+	return file_pingpong_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ExportChunk) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type WatchMatchRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 0 follows every match played while the stream is open.
+	MatchId       int32 `protobuf:"varint,1,opt,name=match_id,json=matchId,proto3" json:"match_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchMatchRequest) Reset() {
+	*x = WatchMatchRequest{}
+	mi := &file_pingpong_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchMatchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchMatchRequest) ProtoMessage() {}
+
+func (x *WatchMatchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pingpong_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchMatchRequest.ProtoReflect.Descriptor instead.
+func (*WatchMatchRequest) Descriptor() ([]byte, []int) {
+	return file_pingpong_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *WatchMatchRequest) GetMatchId() int32 {
+	if x != nil {
+		return x.MatchId
+	}
+	return 0
+}
+
+// PauseMatchRequest holds the ball of the current match (paused = true) or
+// plays on (paused = false).
+type PauseMatchRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Paused        bool                   `protobuf:"varint,1,opt,name=paused,proto3" json:"paused,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PauseMatchRequest) Reset() {
+	*x = PauseMatchRequest{}
+	mi := &file_pingpong_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PauseMatchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PauseMatchRequest) ProtoMessage() {}
+
+func (x *PauseMatchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pingpong_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PauseMatchRequest.ProtoReflect.Descriptor instead.
+func (*PauseMatchRequest) Descriptor() ([]byte, []int) {
+	return file_pingpong_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *PauseMatchRequest) GetPaused() bool {
+	if x != nil {
+		return x.Paused
+	}
+	return false
+}
+
+type PauseMatchResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MatchId       int32                  `protobuf:"varint,1,opt,name=match_id,json=matchId,proto3" json:"match_id,omitempty"`
+	Paused        bool                   `protobuf:"varint,2,opt,name=paused,proto3" json:"paused,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PauseMatchResponse) Reset() {
+	*x = PauseMatchResponse{}
+	mi := &file_pingpong_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PauseMatchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PauseMatchResponse) ProtoMessage() {}
+
+func (x *PauseMatchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pingpong_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PauseMatchResponse.ProtoReflect.Descriptor instead.
+func (*PauseMatchResponse) Descriptor() ([]byte, []int) {
+	return file_pingpong_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *PauseMatchResponse) GetMatchId() int32 {
+	if x != nil {
+		return x.MatchId
+	}
+	return 0
+}
+
+func (x *PauseMatchResponse) GetPaused() bool {
+	if x != nil {
+		return x.Paused
+	}
+	return false
+}
+
+// HitBallRequest is a human player's answer to a SHOT_REQUESTED update.
+type HitBallRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Power         ShotPower              `protobuf:"varint,1,opt,name=power,proto3,enum=pingpong.ShotPower" json:"power,omitempty"`
+	Placement     ShotPlacement          `protobuf:"varint,2,opt,name=placement,proto3,enum=pingpong.ShotPlacement" json:"placement,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HitBallRequest) Reset() {
+	*x = HitBallRequest{}
+	mi := &file_pingpong_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HitBallRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HitBallRequest) ProtoMessage() {}
+
+func (x *HitBallRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pingpong_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HitBallRequest.ProtoReflect.Descriptor instead.
+func (*HitBallRequest) Descriptor() ([]byte, []int) {
+	return file_pingpong_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *HitBallRequest) GetPower() ShotPower {
+	if x != nil {
+		return x.Power
+	}
+	return ShotPower_SHOT_POWER_UNSPECIFIED
+}
+
+func (x *HitBallRequest) GetPlacement() ShotPlacement {
+	if x != nil {
+		return x.Placement
+	}
+	return ShotPlacement_SHOT_PLACEMENT_CENTER
+}
+
+type HitBallResponse struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	ReturnPower int32                  `protobuf:"varint,1,opt,name=return_power,json=returnPower,proto3" json:"return_power,omitempty"`
+	// The shot was hit too hard and went long, losing the point.
+	Out           bool `protobuf:"varint,2,opt,name=out,proto3" json:"out,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HitBallResponse) Reset() {
+	*x = HitBallResponse{}
+	mi := &file_pingpong_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HitBallResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HitBallResponse) ProtoMessage() {}
+
+func (x *HitBallResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pingpong_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HitBallResponse.ProtoReflect.Descriptor instead.
+func (*HitBallResponse) Descriptor() ([]byte, []int) {
+	return file_pingpong_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *HitBallResponse) GetReturnPower() int32 {
+	if x != nil {
+		return x.ReturnPower
+	}
+	return 0
+}
+
+func (x *HitBallResponse) GetOut() bool {
+	if x != nil {
+		return x.Out
+	}
+	return false
+}
+
+type ReplayMatchRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	MatchId int32                  `protobuf:"varint,1,opt,name=match_id,json=matchId,proto3" json:"match_id,omitempty"`
+	// Playback speed multiplier; 0 sends every update immediately.
+	Speed         float64 `protobuf:"fixed64,2,opt,name=speed,proto3" json:"speed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReplayMatchRequest) Reset() {
+	*x = ReplayMatchRequest{}
+	mi := &file_pingpong_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReplayMatchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplayMatchRequest) ProtoMessage() {}
+
+func (x *ReplayMatchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pingpong_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplayMatchRequest.ProtoReflect.Descriptor instead.
+func (*ReplayMatchRequest) Descriptor() ([]byte, []int) {
+	return file_pingpong_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ReplayMatchRequest) GetMatchId() int32 {
+	if x != nil {
+		return x.MatchId
+	}
+	return 0
+}
+
+func (x *ReplayMatchRequest) GetSpeed() float64 {
+	if x != nil {
+		return x.Speed
+	}
+	return 0
+}
+
+type MatchUpdate struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Kind          MatchUpdate_Kind       `protobuf:"varint,1,opt,name=kind,proto3,enum=pingpong.MatchUpdate_Kind" json:"kind,omitempty"`
+	MatchId       int32                  `protobuf:"varint,2,opt,name=match_id,json=matchId,proto3" json:"match_id,omitempty"`
+	MatchNumber   int32                  `protobuf:"varint,3,opt,name=match_number,json=matchNumber,proto3" json:"match_number,omitempty"`
+	Time          *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=time,proto3" json:"time,omitempty"`
+	Turn          *Turn                  `protobuf:"bytes,5,opt,name=turn,proto3" json:"turn,omitempty"`
+	Winner        string                 `protobuf:"bytes,6,opt,name=winner,proto3" json:"winner,omitempty"`
+	Replay        bool                   `protobuf:"varint,7,opt,name=replay,proto3" json:"replay,omitempty"`
+	Deadline      *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=deadline,proto3" json:"deadline,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MatchUpdate) Reset() {
+	*x = MatchUpdate{}
+	mi := &file_pingpong_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MatchUpdate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MatchUpdate) ProtoMessage() {}
+
+func (x *MatchUpdate) ProtoReflect() protoreflect.Message {
+	mi := &file_pingpong_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MatchUpdate.ProtoReflect.Descriptor instead.
+func (*MatchUpdate) Descriptor() ([]byte, []int) {
+	return file_pingpong_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *MatchUpdate) GetKind() MatchUpdate_Kind {
+	if x != nil {
+		return x.Kind
+	}
+	return MatchUpdate_KIND_UNSPECIFIED
+}
+
+func (x *MatchUpdate) GetMatchId() int32 {
+	if x != nil {
+		return x.MatchId
+	}
+	return 0
+}
+
+func (x *MatchUpdate) GetMatchNumber() int32 {
+	if x != nil {
+		return x.MatchNumber
+	}
+	return 0
+}
+
+func (x *MatchUpdate) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+func (x *MatchUpdate) GetTurn() *Turn {
+	if x != nil {
+		return x.Turn
+	}
+	return nil
+}
+
+func (x *MatchUpdate) GetWinner() string {
+	if x != nil {
+		return x.Winner
+	}
+	return ""
+}
+
+func (x *MatchUpdate) GetReplay() bool {
+	if x != nil {
+		return x.Replay
+	}
+	return false
+}
+
+func (x *MatchUpdate) GetDeadline() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Deadline
+	}
+	return nil
+}
+
+type StartGameRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The match's rules; the Table falls back to its own when unset.
+	GameRules     *GameRules `protobuf:"bytes,1,opt,name=game_rules,json=gameRules,proto3" json:"game_rules,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StartGameRequest) Reset() {
+	*x = StartGameRequest{}
+	mi := &file_pingpong_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartGameRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartGameRequest) ProtoMessage() {}
+
+func (x *StartGameRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pingpong_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartGameRequest.ProtoReflect.Descriptor instead.
+func (*StartGameRequest) Descriptor() ([]byte, []int) {
+	return file_pingpong_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *StartGameRequest) GetGameRules() *GameRules {
+	if x != nil {
+		return x.GameRules
+	}
+	return nil
+}
+
+type StartGameResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StartGameResponse) Reset() {
+	*x = StartGameResponse{}
+	mi := &file_pingpong_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartGameResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartGameResponse) ProtoMessage() {}
+
+func (x *StartGameResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pingpong_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartGameResponse.ProtoReflect.Descriptor instead.
+func (*StartGameResponse) Descriptor() ([]byte, []int) {
+	return file_pingpong_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *StartGameResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type ReceiveBallRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BallPower     int32                  `protobuf:"varint,1,opt,name=ball_power,json=ballPower,proto3" json:"ball_power,omitempty"`
+	FromPlayer    string                 `protobuf:"bytes,2,opt,name=from_player,json=fromPlayer,proto3" json:"from_player,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReceiveBallRequest) Reset() {
+	*x = ReceiveBallRequest{}
+	mi := &file_pingpong_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReceiveBallRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReceiveBallRequest) ProtoMessage() {}
+
+func (x *ReceiveBallRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pingpong_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReceiveBallRequest.ProtoReflect.Descriptor instead.
+func (*ReceiveBallRequest) Descriptor() ([]byte, []int) {
+	return file_pingpong_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ReceiveBallRequest) GetBallPower() int32 {
+	if x != nil {
+		return x.BallPower
+	}
+	return 0
+}
+
+func (x *ReceiveBallRequest) GetFromPlayer() string {
+	if x != nil {
+		return x.FromPlayer
+	}
+	return ""
+}
+
+type ReceiveBallResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReceiveBallResponse) Reset() {
+	*x = ReceiveBallResponse{}
+	mi := &file_pingpong_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReceiveBallResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReceiveBallResponse) ProtoMessage() {}
+
+func (x *ReceiveBallResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pingpong_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReceiveBallResponse.ProtoReflect.Descriptor instead.
+func (*ReceiveBallResponse) Descriptor() ([]byte, []int) {
+	return file_pingpong_proto_rawDescGZIP(), []int{26}
+}
+
+type Match struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	MatchNumber int32                  `protobuf:"varint,2,opt,name=match_number,json=matchNumber,proto3" json:"match_number,omitempty"`
+	StartTime   *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	Winner      string                 `protobuf:"bytes,5,opt,name=winner,proto3" json:"winner,omitempty"`
+	Turns       []*Turn                `protobuf:"bytes,6,rep,name=turns,proto3" json:"turns,omitempty"`
+	// Unset for matches recorded before rules were stored with them.
+	GameRules     *GameRules `protobuf:"bytes,7,opt,name=game_rules,json=gameRules,proto3" json:"game_rules,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Match) Reset() {
+	*x = Match{}
+	mi := &file_pingpong_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Match) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Match) ProtoMessage() {}
+
+func (x *Match) ProtoReflect() protoreflect.Message {
+	mi := &file_pingpong_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Match.ProtoReflect.Descriptor instead.
+func (*Match) Descriptor() ([]byte, []int) {
+	return file_pingpong_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *Match) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Match) GetMatchNumber() int32 {
+	if x != nil {
+		return x.MatchNumber
+	}
+	return 0
+}
+
+func (x *Match) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *Match) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *Match) GetWinner() string {
+	if x != nil {
+		return x.Winner
+	}
+	return ""
+}
+
+func (x *Match) GetTurns() []*Turn {
+	if x != nil {
+		return x.Turns
+	}
+	return nil
+}
+
+func (x *Match) GetGameRules() *GameRules {
+	if x != nil {
+		return x.GameRules
+	}
+	return nil
+}
+
+type GameRules struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	TurnLimit      int32                  `protobuf:"varint,1,opt,name=turn_limit,json=turnLimit,proto3" json:"turn_limit,omitempty"`
+	ServePower     *PowerRange            `protobuf:"bytes,2,opt,name=serve_power,json=servePower,proto3" json:"serve_power,omitempty"`
+	ReturnPercentA *PowerRange            `protobuf:"bytes,3,opt,name=return_percent_a,json=returnPercentA,proto3" json:"return_percent_a,omitempty"`
+	ReturnPowerB   *PowerRange            `protobuf:"bytes,4,opt,name=return_power_b,json=returnPowerB,proto3" json:"return_power_b,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GameRules) Reset() {
+	*x = GameRules{}
+	mi := &file_pingpong_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GameRules) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GameRules) ProtoMessage() {}
+
+func (x *GameRules) ProtoReflect() protoreflect.Message {
+	mi := &file_pingpong_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GameRules.ProtoReflect.Descriptor instead.
+func (*GameRules) Descriptor() ([]byte, []int) {
+	return file_pingpong_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *GameRules) GetTurnLimit() int32 {
+	if x != nil {
+		return x.TurnLimit
+	}
+	return 0
+}
+
+func (x *GameRules) GetServePower() *PowerRange {
+	if x != nil {
+		return x.ServePower
+	}
+	return nil
+}
+
+func (x *GameRules) GetReturnPercentA() *PowerRange {
+	if x != nil {
+		return x.ReturnPercentA
+	}
+	return nil
+}
+
+func (x *GameRules) GetReturnPowerB() *PowerRange {
+	if x != nil {
+		return x.ReturnPowerB
+	}
+	return nil
+}
+
+type Schedule struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name  string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Standard five-field cron expression or descriptor such as "@every 1h".
+	Cron string `protobuf:"bytes,3,opt,name=cron,proto3" json:"cron,omitempty"`
+	// Matches played back to back on each run.
+	Matches       int32                  `protobuf:"varint,4,opt,name=matches,proto3" json:"matches,omitempty"`
+	CreateTime    *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Schedule) Reset() {
+	*x = Schedule{}
+	mi := &file_pingpong_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Schedule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Schedule) ProtoMessage() {}
+
+func (x *Schedule) ProtoReflect() protoreflect.Message {
+	mi := &file_pingpong_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Schedule.ProtoReflect.Descriptor instead.
+func (*Schedule) Descriptor() ([]byte, []int) {
+	return file_pingpong_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *Schedule) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Schedule) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Schedule) GetCron() string {
+	if x != nil {
+		return x.Cron
+	}
+	return ""
+}
+
+func (x *Schedule) GetMatches() int32 {
+	if x != nil {
+		return x.Matches
+	}
+	return 0
+}
+
+func (x *Schedule) GetCreateTime() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Winner
+		return x.CreateTime
+	}
+	return nil
+}
+
+type CreateScheduleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Cron          string                 `protobuf:"bytes,2,opt,name=cron,proto3" json:"cron,omitempty"`
+	Matches       int32                  `protobuf:"varint,3,opt,name=matches,proto3" json:"matches,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateScheduleRequest) Reset() {
+	*x = CreateScheduleRequest{}
+	mi := &file_pingpong_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateScheduleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateScheduleRequest) ProtoMessage() {}
+
+func (x *CreateScheduleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pingpong_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateScheduleRequest.ProtoReflect.Descriptor instead.
+func (*CreateScheduleRequest) Descriptor() ([]byte, []int) {
+	return file_pingpong_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *CreateScheduleRequest) GetName() string {
+	if x != nil {
+		return x.Name
 	}
 	return ""
 }
 
-func (x *Match) GetTurns() []*Turn {
+func (x *CreateScheduleRequest) GetCron() string {
 	if x != nil {
-		return x.Turns
+		return x.Cron
+	}
+	return ""
+}
+
+func (x *CreateScheduleRequest) GetMatches() int32 {
+	if x != nil {
+		return x.Matches
+	}
+	return 0
+}
+
+type ListSchedulesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Schedules     []*Schedule            `protobuf:"bytes,1,rep,name=schedules,proto3" json:"schedules,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSchedulesResponse) Reset() {
+	*x = ListSchedulesResponse{}
+	mi := &file_pingpong_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSchedulesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSchedulesResponse) ProtoMessage() {}
+
+func (x *ListSchedulesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pingpong_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSchedulesResponse.ProtoReflect.Descriptor instead.
+func (*ListSchedulesResponse) Descriptor() ([]byte, []int) {
+	return file_pingpong_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ListSchedulesResponse) GetSchedules() []*Schedule {
+	if x != nil {
+		return x.Schedules
 	}
 	return nil
 }
 
+type DeleteScheduleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteScheduleRequest) Reset() {
+	*x = DeleteScheduleRequest{}
+	mi := &file_pingpong_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteScheduleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteScheduleRequest) ProtoMessage() {}
+
+func (x *DeleteScheduleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pingpong_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteScheduleRequest.ProtoReflect.Descriptor instead.
+func (*DeleteScheduleRequest) Descriptor() ([]byte, []int) {
+	return file_pingpong_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *DeleteScheduleRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type PowerRange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Min           int32                  `protobuf:"varint,1,opt,name=min,proto3" json:"min,omitempty"`
+	Max           int32                  `protobuf:"varint,2,opt,name=max,proto3" json:"max,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PowerRange) Reset() {
+	*x = PowerRange{}
+	mi := &file_pingpong_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PowerRange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PowerRange) ProtoMessage() {}
+
+func (x *PowerRange) ProtoReflect() protoreflect.Message {
+	mi := &file_pingpong_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PowerRange.ProtoReflect.Descriptor instead.
+func (*PowerRange) Descriptor() ([]byte, []int) {
+	return file_pingpong_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *PowerRange) GetMin() int32 {
+	if x != nil {
+		return x.Min
+	}
+	return 0
+}
+
+func (x *PowerRange) GetMax() int32 {
+	if x != nil {
+		return x.Max
+	}
+	return 0
+}
+
 type Turn struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -656,7 +2057,7 @@ type Turn struct {
 
 func (x *Turn) Reset() {
 	*x = Turn{}
-	mi := &file_pingpong_proto_msgTypes[14]
+	mi := &file_pingpong_proto_msgTypes[34]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -668,7 +2069,7 @@ func (x *Turn) String() string {
 func (*Turn) ProtoMessage() {}
 
 func (x *Turn) ProtoReflect() protoreflect.Message {
-	mi := &file_pingpong_proto_msgTypes[14]
+	mi := &file_pingpong_proto_msgTypes[34]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -681,7 +2082,7 @@ func (x *Turn) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Turn.ProtoReflect.Descriptor instead.
 func (*Turn) Descriptor() ([]byte, []int) {
-	return file_pingpong_proto_rawDescGZIP(), []int{14}
+	return file_pingpong_proto_rawDescGZIP(), []int{34}
 }
 
 func (x *Turn) GetId() int32 {
@@ -733,62 +2134,378 @@ func (x *Turn) GetMatchNumber() int32 {
 	return 0
 }
 
+type PlayerStats struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Hits           int32                  `protobuf:"varint,1,opt,name=hits,proto3" json:"hits,omitempty"`
+	AverageReceive float64                `protobuf:"fixed64,2,opt,name=average_receive,json=averageReceive,proto3" json:"average_receive,omitempty"`
+	AverageReturn  float64                `protobuf:"fixed64,3,opt,name=average_return,json=averageReturn,proto3" json:"average_return,omitempty"`
+	MaxReturn      int32                  `protobuf:"varint,4,opt,name=max_return,json=maxReturn,proto3" json:"max_return,omitempty"`
+	Points         int32                  `protobuf:"varint,5,opt,name=points,proto3" json:"points,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *PlayerStats) Reset() {
+	*x = PlayerStats{}
+	mi := &file_pingpong_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlayerStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayerStats) ProtoMessage() {}
+
+func (x *PlayerStats) ProtoReflect() protoreflect.Message {
+	mi := &file_pingpong_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayerStats.ProtoReflect.Descriptor instead.
+func (*PlayerStats) Descriptor() ([]byte, []int) {
+	return file_pingpong_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *PlayerStats) GetHits() int32 {
+	if x != nil {
+		return x.Hits
+	}
+	return 0
+}
+
+func (x *PlayerStats) GetAverageReceive() float64 {
+	if x != nil {
+		return x.AverageReceive
+	}
+	return 0
+}
+
+func (x *PlayerStats) GetAverageReturn() float64 {
+	if x != nil {
+		return x.AverageReturn
+	}
+	return 0
+}
+
+func (x *PlayerStats) GetMaxReturn() int32 {
+	if x != nil {
+		return x.MaxReturn
+	}
+	return 0
+}
+
+func (x *PlayerStats) GetPoints() int32 {
+	if x != nil {
+		return x.Points
+	}
+	return 0
+}
+
+type MatchStats struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	MatchId       int32                   `protobuf:"varint,1,opt,name=match_id,json=matchId,proto3" json:"match_id,omitempty"`
+	Rally         int32                   `protobuf:"varint,2,opt,name=rally,proto3" json:"rally,omitempty"`
+	Duration      *durationpb.Duration    `protobuf:"bytes,3,opt,name=duration,proto3" json:"duration,omitempty"`
+	Pauses        int32                   `protobuf:"varint,4,opt,name=pauses,proto3" json:"pauses,omitempty"`
+	Winner        string                  `protobuf:"bytes,5,opt,name=winner,proto3" json:"winner,omitempty"`
+	FinishedBy    string                  `protobuf:"bytes,6,opt,name=finished_by,json=finishedBy,proto3" json:"finished_by,omitempty"`
+	PlayerStats   map[string]*PlayerStats `protobuf:"bytes,7,rep,name=player_stats,json=playerStats,proto3" json:"player_stats,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MatchStats) Reset() {
+	*x = MatchStats{}
+	mi := &file_pingpong_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MatchStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MatchStats) ProtoMessage() {}
+
+func (x *MatchStats) ProtoReflect() protoreflect.Message {
+	mi := &file_pingpong_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MatchStats.ProtoReflect.Descriptor instead.
+func (*MatchStats) Descriptor() ([]byte, []int) {
+	return file_pingpong_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *MatchStats) GetMatchId() int32 {
+	if x != nil {
+		return x.MatchId
+	}
+	return 0
+}
+
+func (x *MatchStats) GetRally() int32 {
+	if x != nil {
+		return x.Rally
+	}
+	return 0
+}
+
+func (x *MatchStats) GetDuration() *durationpb.Duration {
+	if x != nil {
+		return x.Duration
+	}
+	return nil
+}
+
+func (x *MatchStats) GetPauses() int32 {
+	if x != nil {
+		return x.Pauses
+	}
+	return 0
+}
+
+func (x *MatchStats) GetWinner() string {
+	if x != nil {
+		return x.Winner
+	}
+	return ""
+}
+
+func (x *MatchStats) GetFinishedBy() string {
+	if x != nil {
+		return x.FinishedBy
+	}
+	return ""
+}
+
+func (x *MatchStats) GetPlayerStats() map[string]*PlayerStats {
+	if x != nil {
+		return x.PlayerStats
+	}
+	return nil
+}
+
 var File_pingpong_proto protoreflect.FileDescriptor
 
 const file_pingpong_proto_rawDesc = "" +
 	"\n" +
-	"\x0epingpong.proto\x12\bpingpong\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\".\n" +
+	"\x0epingpong.proto\x12\bpingpong\x1a\x1cgoogle/api/annotations.proto\x1a\x1egoogle/protobuf/duration.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\vrules.proto\".\n" +
 	"\x14IsGameActiveResponse\x12\x16\n" +
 	"\x06active\x18\x01 \x01(\bR\x06active\"\x11\n" +
 	"\x0fNewMatchRequest\",\n" +
 	"\x10NewMatchResponse\x12\x18\n" +
-	"\amessage\x18\x01 \x01(\tR\amessage\",\n" +
-	"\vPingRequest\x12\x1d\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\"D\n" +
+	"\vPingRequest\x125\n" +
 	"\n" +
-	"ball_power\x18\x01 \x01(\x05R\tballPower\"\x0e\n" +
+	"ball_power\x18\x01 \x01(\x05B\x16\x8a\xb5\x18\x12\t\x00\x00\x00\x00\x00\x00\x00\x00\x11\x00\x00\x00\x00\x00\x00Y@R\tballPower\"\x0e\n" +
 	"\fPingResponse\"\x11\n" +
-	"\x0fGetMatchRequest\"%\n" +
-	"\x13GetMatchByIDRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x05R\x02id\"\x0f\n" +
-	"\rTestDBRequest\"*\n" +
+	"\x0fGetMatchRequest\"4\n" +
+	"\x13GetMatchByIDRequest\x12\x1d\n" +
+	"\x02id\x18\x01 \x01(\x05B\r\x8a\xb5\x18\t\t\x00\x00\x00\x00\x00\x00\xf0?R\x02id\"\xbe\x01\n" +
+	"\x12ListMatchesRequest\x12.\n" +
+	"\x04from\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x04from\x12*\n" +
+	"\x02to\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x02to\x12\x1e\n" +
+	"\x06player\x18\x03 \x01(\tB\x06\x8a\xb5\x18\x02(\n" +
+	"R\x06player\x12,\n" +
+	"\x05limit\x18\x04 \x01(\x05B\x16\x8a\xb5\x18\x12\t\x00\x00\x00\x00\x00\x00\x00\x00\x11\x00\x00\x00\x00\x00@\x8f@R\x05limit\"@\n" +
+	"\x13ListMatchesResponse\x12)\n" +
+	"\amatches\x18\x01 \x03(\v2\x0f.pingpong.MatchR\amatches\"-\n" +
+	"\x11SaveMatchResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\">\n" +
+	"\x15GetPlayerStatsRequest\x12%\n" +
+	"\tplayer_id\x18\x01 \x01(\tB\b\x8a\xb5\x18\x04 \x01(\n" +
+	"R\bplayerId\"\xca\x01\n" +
+	"\rPlayerSummary\x12\x1b\n" +
+	"\tplayer_id\x18\x01 \x01(\tR\bplayerId\x12\x18\n" +
+	"\amatches\x18\x02 \x01(\x05R\amatches\x12\x12\n" +
+	"\x04wins\x18\x03 \x01(\x05R\x04wins\x12\x16\n" +
+	"\x06losses\x18\x04 \x01(\x05R\x06losses\x12\x14\n" +
+	"\x05draws\x18\x05 \x01(\x05R\x05draws\x12\x12\n" +
+	"\x04hits\x18\x06 \x01(\x05R\x04hits\x12,\n" +
+	"\x12average_ball_power\x18\a \x01(\x01R\x10averageBallPower\"\x0f\n" +
+	"\rTestDBRequest\"p\n" +
 	"\x0eTestDBResponse\x12\x18\n" +
-	"\amessage\x18\x01 \x01(\tR\amessage\"\x12\n" +
-	"\x10StartGameRequest\"-\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\x12\x1d\n" +
+	"\n" +
+	"latency_ms\x18\x02 \x01(\x01R\tlatencyMs\x12%\n" +
+	"\x0eschema_version\x18\x03 \x01(\x05R\rschemaVersion\"\xca\x01\n" +
+	"\x14ExportMatchesRequest\x126\n" +
+	"\x06format\x18\x01 \x01(\x0e2\x16.pingpong.ExportFormatB\x06\x8a\xb5\x18\x020\x01R\x06format\x12.\n" +
+	"\x04from\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x04from\x12*\n" +
+	"\x02to\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x02to\x12\x1e\n" +
+	"\x06player\x18\x04 \x01(\tB\x06\x8a\xb5\x18\x02(\n" +
+	"R\x06player\"!\n" +
+	"\vExportChunk\x12\x12\n" +
+	"\x04data\x18\x01 \x01(\fR\x04data\"=\n" +
+	"\x11WatchMatchRequest\x12(\n" +
+	"\bmatch_id\x18\x01 \x01(\x05B\r\x8a\xb5\x18\t\t\x00\x00\x00\x00\x00\x00\x00\x00R\amatchId\"+\n" +
+	"\x11PauseMatchRequest\x12\x16\n" +
+	"\x06paused\x18\x01 \x01(\bR\x06paused\"G\n" +
+	"\x12PauseMatchResponse\x12\x19\n" +
+	"\bmatch_id\x18\x01 \x01(\x05R\amatchId\x12\x16\n" +
+	"\x06paused\x18\x02 \x01(\bR\x06paused\"\x84\x01\n" +
+	"\x0eHitBallRequest\x123\n" +
+	"\x05power\x18\x01 \x01(\x0e2\x13.pingpong.ShotPowerB\b\x8a\xb5\x18\x04 \x010\x01R\x05power\x12=\n" +
+	"\tplacement\x18\x02 \x01(\x0e2\x17.pingpong.ShotPlacementB\x06\x8a\xb5\x18\x020\x01R\tplacement\"F\n" +
+	"\x0fHitBallResponse\x12!\n" +
+	"\freturn_power\x18\x01 \x01(\x05R\vreturnPower\x12\x10\n" +
+	"\x03out\x18\x02 \x01(\bR\x03out\"l\n" +
+	"\x12ReplayMatchRequest\x12(\n" +
+	"\bmatch_id\x18\x01 \x01(\x05B\r\x8a\xb5\x18\t\t\x00\x00\x00\x00\x00\x00\xf0?R\amatchId\x12,\n" +
+	"\x05speed\x18\x02 \x01(\x01B\x16\x8a\xb5\x18\x12\t\x00\x00\x00\x00\x00\x00\x00\x00\x11\x00\x00\x00\x00\x00\x00Y@R\x05speed\"\xb3\x03\n" +
+	"\vMatchUpdate\x12.\n" +
+	"\x04kind\x18\x01 \x01(\x0e2\x1a.pingpong.MatchUpdate.KindR\x04kind\x12\x19\n" +
+	"\bmatch_id\x18\x02 \x01(\x05R\amatchId\x12!\n" +
+	"\fmatch_number\x18\x03 \x01(\x05R\vmatchNumber\x12.\n" +
+	"\x04time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x04time\x12\"\n" +
+	"\x04turn\x18\x05 \x01(\v2\x0e.pingpong.TurnR\x04turn\x12\x16\n" +
+	"\x06winner\x18\x06 \x01(\tR\x06winner\x12\x16\n" +
+	"\x06replay\x18\a \x01(\bR\x06replay\x126\n" +
+	"\bdeadline\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\bdeadline\"z\n" +
+	"\x04Kind\x12\x14\n" +
+	"\x10KIND_UNSPECIFIED\x10\x00\x12\x11\n" +
+	"\rMATCH_STARTED\x10\x01\x12\b\n" +
+	"\x04TURN\x10\x02\x12\x12\n" +
+	"\x0eMATCH_FINISHED\x10\x03\x12\n" +
+	"\n" +
+	"\x06PAUSED\x10\x04\x12\v\n" +
+	"\aRESUMED\x10\x05\x12\x12\n" +
+	"\x0eSHOT_REQUESTED\x10\x06\"F\n" +
+	"\x10StartGameRequest\x122\n" +
+	"\n" +
+	"game_rules\x18\x01 \x01(\v2\x13.pingpong.GameRulesR\tgameRules\"-\n" +
 	"\x11StartGameResponse\x12\x18\n" +
-	"\amessage\x18\x01 \x01(\tR\amessage\"T\n" +
-	"\x12ReceiveBallRequest\x12\x1d\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\"x\n" +
+	"\x12ReceiveBallRequest\x125\n" +
 	"\n" +
-	"ball_power\x18\x01 \x01(\x05R\tballPower\x12\x1f\n" +
-	"\vfrom_player\x18\x02 \x01(\tR\n" +
+	"ball_power\x18\x01 \x01(\x05B\x16\x8a\xb5\x18\x12\t\x00\x00\x00\x00\x00\x00\x00\x00\x11\x00\x00\x00\x00\x00\x00Y@R\tballPower\x12+\n" +
+	"\vfrom_player\x18\x02 \x01(\tB\n" +
+	"\x8a\xb5\x18\x06\x1a\x01A\x1a\x01BR\n" +
 	"fromPlayer\"\x15\n" +
-	"\x13ReceiveBallResponse\"\xea\x01\n" +
-	"\x05Match\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x05R\x02id\x12!\n" +
-	"\fmatch_number\x18\x02 \x01(\x05R\vmatchNumber\x129\n" +
+	"\x13ReceiveBallResponse\"\xe1\x02\n" +
+	"\x05Match\x12\x1d\n" +
+	"\x02id\x18\x01 \x01(\x05B\r\x8a\xb5\x18\t\t\x00\x00\x00\x00\x00\x00\x00\x00R\x02id\x120\n" +
+	"\fmatch_number\x18\x02 \x01(\x05B\r\x8a\xb5\x18\t\t\x00\x00\x00\x00\x00\x00\xf0?R\vmatchNumber\x12A\n" +
+	"\n" +
+	"start_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampB\x06\x8a\xb5\x18\x02 \x01R\tstartTime\x125\n" +
+	"\bend_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\aendTime\x123\n" +
+	"\x06winner\x18\x05 \x01(\tB\x1b\x8a\xb5\x18\x17\x1a\x00\x1a\x01A\x1a\x01B\x1a\x04Draw\x1a\aAbortedR\x06winner\x12$\n" +
+	"\x05turns\x18\x06 \x03(\v2\x0e.pingpong.TurnR\x05turns\x122\n" +
+	"\n" +
+	"game_rules\x18\a \x01(\v2\x13.pingpong.GameRulesR\tgameRules\"\x84\x02\n" +
+	"\tGameRules\x12,\n" +
+	"\n" +
+	"turn_limit\x18\x01 \x01(\x05B\r\x8a\xb5\x18\t\t\x00\x00\x00\x00\x00\x00\xf0?R\tturnLimit\x12=\n" +
+	"\vserve_power\x18\x02 \x01(\v2\x14.pingpong.PowerRangeB\x06\x8a\xb5\x18\x02 \x01R\n" +
+	"servePower\x12F\n" +
+	"\x10return_percent_a\x18\x03 \x01(\v2\x14.pingpong.PowerRangeB\x06\x8a\xb5\x18\x02 \x01R\x0ereturnPercentA\x12B\n" +
+	"\x0ereturn_power_b\x18\x04 \x01(\v2\x14.pingpong.PowerRangeB\x06\x8a\xb5\x18\x02 \x01R\freturnPowerB\"\x99\x01\n" +
+	"\bSchedule\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
+	"\x04cron\x18\x03 \x01(\tR\x04cron\x12\x18\n" +
+	"\amatches\x18\x04 \x01(\x05R\amatches\x12;\n" +
+	"\vcreate_time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"createTime\"\x85\x01\n" +
+	"\x15CreateScheduleRequest\x12\x1c\n" +
+	"\x04name\x18\x01 \x01(\tB\b\x8a\xb5\x18\x04 \x01(dR\x04name\x12\x1c\n" +
+	"\x04cron\x18\x02 \x01(\tB\b\x8a\xb5\x18\x04 \x01(dR\x04cron\x120\n" +
+	"\amatches\x18\x03 \x01(\x05B\x16\x8a\xb5\x18\x12\t\x00\x00\x00\x00\x00\x00\xf0?\x11\x00\x00\x00\x00\x00j\xf8@R\amatches\"I\n" +
+	"\x15ListSchedulesResponse\x120\n" +
+	"\tschedules\x18\x01 \x03(\v2\x12.pingpong.ScheduleR\tschedules\"6\n" +
+	"\x15DeleteScheduleRequest\x12\x1d\n" +
+	"\x02id\x18\x01 \x01(\x05B\r\x8a\xb5\x18\t\t\x00\x00\x00\x00\x00\x00\xf0?R\x02id\"`\n" +
 	"\n" +
-	"start_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tstartTime\x125\n" +
-	"\bend_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\aendTime\x12\x16\n" +
-	"\x06winner\x18\x05 \x01(\tR\x06winner\x12$\n" +
-	"\x05turns\x18\x06 \x03(\v2\x0e.pingpong.TurnR\x05turns\"\xe0\x01\n" +
-	"\x04Turn\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x1f\n" +
-	"\vturn_number\x18\x02 \x01(\x05R\n" +
-	"turnNumber\x12.\n" +
-	"\x04time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x04time\x12\x16\n" +
-	"\x06player\x18\x04 \x01(\tR\x06player\x12\x1d\n" +
+	"PowerRange\x12(\n" +
+	"\x03min\x18\x01 \x01(\x05B\x16\x8a\xb5\x18\x12\t\x00\x00\x00\x00\x00\x00\x00\x00\x11\x00\x00\x00\x00\x00\x00Y@R\x03min\x12(\n" +
+	"\x03max\x18\x02 \x01(\x05B\x16\x8a\xb5\x18\x12\t\x00\x00\x00\x00\x00\x00\x00\x00\x11\x00\x00\x00\x00\x00\x00Y@R\x03max\"\xc1\x02\n" +
+	"\x04Turn\x12\x1d\n" +
+	"\x02id\x18\x01 \x01(\x05B\r\x8a\xb5\x18\t\t\x00\x00\x00\x00\x00\x00\x00\x00R\x02id\x12.\n" +
+	"\vturn_number\x18\x02 \x01(\x05B\r\x8a\xb5\x18\t\t\x00\x00\x00\x00\x00\x00\xf0?R\n" +
+	"turnNumber\x126\n" +
+	"\x04time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampB\x06\x8a\xb5\x18\x02 \x01R\x04time\x12\"\n" +
+	"\x06player\x18\x04 \x01(\tB\n" +
+	"\x8a\xb5\x18\x06\x1a\x01A\x1a\x01BR\x06player\x125\n" +
 	"\n" +
-	"ball_power\x18\x05 \x01(\x05R\tballPower\x12\x1d\n" +
+	"ball_power\x18\x05 \x01(\x05B\x16\x8a\xb5\x18\x12\t\x00\x00\x00\x00\x00\x00\x00\x00\x11\x00\x00\x00\x00\x00\x00Y@R\tballPower\x12%\n" +
 	"\n" +
-	"routine_id\x18\x06 \x01(\tR\troutineId\x12!\n" +
-	"\fmatch_number\x18\a \x01(\x05R\vmatchNumber2\xd0\x03\n" +
+	"routine_id\x18\x06 \x01(\tB\x06\x8a\xb5\x18\x02(2R\troutineId\x120\n" +
+	"\fmatch_number\x18\a \x01(\x05B\r\x8a\xb5\x18\t\t\x00\x00\x00\x00\x00\x00\x00\x00R\vmatchNumber\"\xa8\x01\n" +
+	"\vPlayerStats\x12\x12\n" +
+	"\x04hits\x18\x01 \x01(\x05R\x04hits\x12'\n" +
+	"\x0faverage_receive\x18\x02 \x01(\x01R\x0eaverageReceive\x12%\n" +
+	"\x0eaverage_return\x18\x03 \x01(\x01R\raverageReturn\x12\x1d\n" +
+	"\n" +
+	"max_return\x18\x04 \x01(\x05R\tmaxReturn\x12\x16\n" +
+	"\x06points\x18\x05 \x01(\x05R\x06points\"\xe6\x02\n" +
+	"\n" +
+	"MatchStats\x12\x19\n" +
+	"\bmatch_id\x18\x01 \x01(\x05R\amatchId\x12\x14\n" +
+	"\x05rally\x18\x02 \x01(\x05R\x05rally\x125\n" +
+	"\bduration\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\bduration\x12\x16\n" +
+	"\x06pauses\x18\x04 \x01(\x05R\x06pauses\x12\x16\n" +
+	"\x06winner\x18\x05 \x01(\tR\x06winner\x12\x1f\n" +
+	"\vfinished_by\x18\x06 \x01(\tR\n" +
+	"finishedBy\x12H\n" +
+	"\fplayer_stats\x18\a \x03(\v2%.pingpong.MatchStats.PlayerStatsEntryR\vplayerStats\x1aU\n" +
+	"\x10PlayerStatsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12+\n" +
+	"\x05value\x18\x02 \x01(\v2\x15.pingpong.PlayerStatsR\x05value:\x028\x01*Y\n" +
+	"\fExportFormat\x12\x17\n" +
+	"\x13EXPORT_FORMAT_JSONL\x10\x00\x12\x15\n" +
+	"\x11EXPORT_FORMAT_CSV\x10\x01\x12\x19\n" +
+	"\x15EXPORT_FORMAT_PARQUET\x10\x02*h\n" +
+	"\tShotPower\x12\x1a\n" +
+	"\x16SHOT_POWER_UNSPECIFIED\x10\x00\x12\x13\n" +
+	"\x0fSHOT_POWER_SOFT\x10\x01\x12\x15\n" +
+	"\x11SHOT_POWER_MEDIUM\x10\x02\x12\x13\n" +
+	"\x0fSHOT_POWER_HARD\x10\x03*]\n" +
+	"\rShotPlacement\x12\x19\n" +
+	"\x15SHOT_PLACEMENT_CENTER\x10\x00\x12\x17\n" +
+	"\x13SHOT_PLACEMENT_LEFT\x10\x01\x12\x18\n" +
+	"\x14SHOT_PLACEMENT_RIGHT\x10\x022\x94\f\n" +
 	"\rPlayerService\x12F\n" +
 	"\rStartNewMatch\x12\x19.pingpong.NewMatchRequest\x1a\x1a.pingpong.NewMatchResponse\x12<\n" +
 	"\vPlayerAPing\x12\x15.pingpong.PingRequest\x1a\x16.pingpong.PingResponse\x12<\n" +
-	"\vPlayerBPing\x12\x15.pingpong.PingRequest\x1a\x16.pingpong.PingResponse\x126\n" +
-	"\bGetMatch\x12\x19.pingpong.GetMatchRequest\x1a\x0f.pingpong.Match\x12>\n" +
-	"\fGetMatchByID\x12\x1d.pingpong.GetMatchByIDRequest\x1a\x0f.pingpong.Match\x12;\n" +
+	"\vPlayerBPing\x12\x15.pingpong.PingRequest\x1a\x16.pingpong.PingResponse\x12O\n" +
+	"\bGetMatch\x12\x19.pingpong.GetMatchRequest\x1a\x0f.pingpong.Match\"\x17\x82\xd3\xe4\x93\x02\x11\x12\x0f/matches/latest\x12U\n" +
+	"\fGetMatchByID\x12\x1d.pingpong.GetMatchByIDRequest\x1a\x0f.pingpong.Match\"\x15\x82\xd3\xe4\x93\x02\x0f\x12\r/matches/{id}\x12\\\n" +
+	"\vListMatches\x12\x1c.pingpong.ListMatchesRequest\x1a\x1d.pingpong.ListMatchesResponse\"\x10\x82\xd3\xe4\x93\x02\n" +
+	"\x12\b/matches\x12N\n" +
+	"\tSaveMatch\x12\x0f.pingpong.Match\x1a\x1b.pingpong.SaveMatchResponse\"\x13\x82\xd3\xe4\x93\x02\r:\x01*\"\b/matches\x12n\n" +
+	"\x0eGetPlayerStats\x12\x1f.pingpong.GetPlayerStatsRequest\x1a\x17.pingpong.PlayerSummary\"\"\x82\xd3\xe4\x93\x02\x1c\x12\x1a/players/{player_id}/stats\x12;\n" +
 	"\x06TestDB\x12\x17.pingpong.TestDBRequest\x1a\x18.pingpong.TestDBResponse\x12F\n" +
-	"\fIsGameActive\x12\x16.google.protobuf.Empty\x1a\x1e.pingpong.IsGameActiveResponse2\xa0\x01\n" +
+	"\fIsGameActive\x12\x16.google.protobuf.Empty\x1a\x1e.pingpong.IsGameActiveResponse\x12H\n" +
+	"\rExportMatches\x12\x1e.pingpong.ExportMatchesRequest\x1a\x15.pingpong.ExportChunk0\x01\x12D\n" +
+	"\rGetMatchStats\x12\x1d.pingpong.GetMatchByIDRequest\x1a\x14.pingpong.MatchStats\x12B\n" +
+	"\n" +
+	"WatchMatch\x12\x1b.pingpong.WatchMatchRequest\x1a\x15.pingpong.MatchUpdate0\x01\x12D\n" +
+	"\vReplayMatch\x12\x1c.pingpong.ReplayMatchRequest\x1a\x15.pingpong.MatchUpdate0\x01\x12G\n" +
+	"\n" +
+	"PauseMatch\x12\x1b.pingpong.PauseMatchRequest\x1a\x1c.pingpong.PauseMatchResponse\x12>\n" +
+	"\aHitBall\x12\x18.pingpong.HitBallRequest\x1a\x19.pingpong.HitBallResponse\x12;\n" +
+	"\fGetGameRules\x12\x16.google.protobuf.Empty\x1a\x13.pingpong.GameRules\x128\n" +
+	"\fSetGameRules\x12\x13.pingpong.GameRules\x1a\x13.pingpong.GameRules\x12E\n" +
+	"\x0eCreateSchedule\x12\x1f.pingpong.CreateScheduleRequest\x1a\x12.pingpong.Schedule\x12H\n" +
+	"\rListSchedules\x12\x16.google.protobuf.Empty\x1a\x1f.pingpong.ListSchedulesResponse\x12I\n" +
+	"\x0eDeleteSchedule\x12\x1f.pingpong.DeleteScheduleRequest\x1a\x16.google.protobuf.Empty2\xa0\x01\n" +
 	"\fTableService\x12D\n" +
 	"\tStartGame\x12\x1a.pingpong.StartGameRequest\x1a\x1b.pingpong.StartGameResponse\x12J\n" +
 	"\vReceiveBall\x12\x1c.pingpong.ReceiveBallRequest\x1a\x1d.pingpong.ReceiveBallResponseB\x10Z\x0epingpong/protob\x06proto3"
@@ -805,54 +2522,133 @@ func file_pingpong_proto_rawDescGZIP() []byte {
 	return file_pingpong_proto_rawDescData
 }
 
-var file_pingpong_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
+var file_pingpong_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_pingpong_proto_msgTypes = make([]protoimpl.MessageInfo, 38)
 var file_pingpong_proto_goTypes = []any{
-	(*IsGameActiveResponse)(nil),  // 0: pingpong.IsGameActiveResponse
-	(*NewMatchRequest)(nil),       // 1: pingpong.NewMatchRequest
-	(*NewMatchResponse)(nil),      // 2: pingpong.NewMatchResponse
-	(*PingRequest)(nil),           // 3: pingpong.PingRequest
-	(*PingResponse)(nil),          // 4: pingpong.PingResponse
-	(*GetMatchRequest)(nil),       // 5: pingpong.GetMatchRequest
-	(*GetMatchByIDRequest)(nil),   // 6: pingpong.GetMatchByIDRequest
-	(*TestDBRequest)(nil),         // 7: pingpong.TestDBRequest
-	(*TestDBResponse)(nil),        // 8: pingpong.TestDBResponse
-	(*StartGameRequest)(nil),      // 9: pingpong.StartGameRequest
-	(*StartGameResponse)(nil),     // 10: pingpong.StartGameResponse
-	(*ReceiveBallRequest)(nil),    // 11: pingpong.ReceiveBallRequest
-	(*ReceiveBallResponse)(nil),   // 12: pingpong.ReceiveBallResponse
-	(*Match)(nil),                 // 13: pingpong.Match
-	(*Turn)(nil),                  // 14: pingpong.Turn
-	(*timestamppb.Timestamp)(nil), // 15: google.protobuf.Timestamp
-	(*emptypb.Empty)(nil),         // 16: google.protobuf.Empty
+	(ExportFormat)(0),             // 0: pingpong.ExportFormat
+	(ShotPower)(0),                // 1: pingpong.ShotPower
+	(ShotPlacement)(0),            // 2: pingpong.ShotPlacement
+	(MatchUpdate_Kind)(0),         // 3: pingpong.MatchUpdate.Kind
+	(*IsGameActiveResponse)(nil),  // 4: pingpong.IsGameActiveResponse
+	(*NewMatchRequest)(nil),       // 5: pingpong.NewMatchRequest
+	(*NewMatchResponse)(nil),      // 6: pingpong.NewMatchResponse
+	(*PingRequest)(nil),           // 7: pingpong.PingRequest
+	(*PingResponse)(nil),          // 8: pingpong.PingResponse
+	(*GetMatchRequest)(nil),       // 9: pingpong.GetMatchRequest
+	(*GetMatchByIDRequest)(nil),   // 10: pingpong.GetMatchByIDRequest
+	(*ListMatchesRequest)(nil),    // 11: pingpong.ListMatchesRequest
+	(*ListMatchesResponse)(nil),   // 12: pingpong.ListMatchesResponse
+	(*SaveMatchResponse)(nil),     // 13: pingpong.SaveMatchResponse
+	(*GetPlayerStatsRequest)(nil), // 14: pingpong.GetPlayerStatsRequest
+	(*PlayerSummary)(nil),         // 15: pingpong.PlayerSummary
+	(*TestDBRequest)(nil),         // 16: pingpong.TestDBRequest
+	(*TestDBResponse)(nil),        // 17: pingpong.TestDBResponse
+	(*ExportMatchesRequest)(nil),  // 18: pingpong.ExportMatchesRequest
+	(*ExportChunk)(nil),           // 19: pingpong.ExportChunk
+	(*WatchMatchRequest)(nil),     // 20: pingpong.WatchMatchRequest
+	(*PauseMatchRequest)(nil),     // 21: pingpong.PauseMatchRequest
+	(*PauseMatchResponse)(nil),    // 22: pingpong.PauseMatchResponse
+	(*HitBallRequest)(nil),        // 23: pingpong.HitBallRequest
+	(*HitBallResponse)(nil),       // 24: pingpong.HitBallResponse
+	(*ReplayMatchRequest)(nil),    // 25: pingpong.ReplayMatchRequest
+	(*MatchUpdate)(nil),           // 26: pingpong.MatchUpdate
+	(*StartGameRequest)(nil),      // 27: pingpong.StartGameRequest
+	(*StartGameResponse)(nil),     // 28: pingpong.StartGameResponse
+	(*ReceiveBallRequest)(nil),    // 29: pingpong.ReceiveBallRequest
+	(*ReceiveBallResponse)(nil),   // 30: pingpong.ReceiveBallResponse
+	(*Match)(nil),                 // 31: pingpong.Match
+	(*GameRules)(nil),             // 32: pingpong.GameRules
+	(*Schedule)(nil),              // 33: pingpong.Schedule
+	(*CreateScheduleRequest)(nil), // 34: pingpong.CreateScheduleRequest
+	(*ListSchedulesResponse)(nil), // 35: pingpong.ListSchedulesResponse
+	(*DeleteScheduleRequest)(nil), // 36: pingpong.DeleteScheduleRequest
+	(*PowerRange)(nil),            // 37: pingpong.PowerRange
+	(*Turn)(nil),                  // 38: pingpong.Turn
+	(*PlayerStats)(nil),           // 39: pingpong.PlayerStats
+	(*MatchStats)(nil),            // 40: pingpong.MatchStats
+	nil,                           // 41: pingpong.MatchStats.PlayerStatsEntry
+	(*timestamppb.Timestamp)(nil), // 42: google.protobuf.Timestamp
+	(*durationpb.Duration)(nil),   // 43: google.protobuf.Duration
+	(*emptypb.Empty)(nil),         // 44: google.protobuf.Empty
 }
 var file_pingpong_proto_depIdxs = []int32{
-	15, // 0: pingpong.Match.start_time:type_name -> google.protobuf.Timestamp
-	15, // 1: pingpong.Match.end_time:type_name -> google.protobuf.Timestamp
-	14, // 2: pingpong.Match.turns:type_name -> pingpong.Turn
-	15, // 3: pingpong.Turn.time:type_name -> google.protobuf.Timestamp
-	1,  // 4: pingpong.PlayerService.StartNewMatch:input_type -> pingpong.NewMatchRequest
-	3,  // 5: pingpong.PlayerService.PlayerAPing:input_type -> pingpong.PingRequest
-	3,  // 6: pingpong.PlayerService.PlayerBPing:input_type -> pingpong.PingRequest
-	5,  // 7: pingpong.PlayerService.GetMatch:input_type -> pingpong.GetMatchRequest
-	6,  // 8: pingpong.PlayerService.GetMatchByID:input_type -> pingpong.GetMatchByIDRequest
-	7,  // 9: pingpong.PlayerService.TestDB:input_type -> pingpong.TestDBRequest
-	16, // 10: pingpong.PlayerService.IsGameActive:input_type -> google.protobuf.Empty
-	9,  // 11: pingpong.TableService.StartGame:input_type -> pingpong.StartGameRequest
-	11, // 12: pingpong.TableService.ReceiveBall:input_type -> pingpong.ReceiveBallRequest
-	2,  // 13: pingpong.PlayerService.StartNewMatch:output_type -> pingpong.NewMatchResponse
-	4,  // 14: pingpong.PlayerService.PlayerAPing:output_type -> pingpong.PingResponse
-	4,  // 15: pingpong.PlayerService.PlayerBPing:output_type -> pingpong.PingResponse
-	13, // 16: pingpong.PlayerService.GetMatch:output_type -> pingpong.Match
-	13, // 17: pingpong.PlayerService.GetMatchByID:output_type -> pingpong.Match
-	8,  // 18: pingpong.PlayerService.TestDB:output_type -> pingpong.TestDBResponse
-	0,  // 19: pingpong.PlayerService.IsGameActive:output_type -> pingpong.IsGameActiveResponse
-	10, // 20: pingpong.TableService.StartGame:output_type -> pingpong.StartGameResponse
-	12, // 21: pingpong.TableService.ReceiveBall:output_type -> pingpong.ReceiveBallResponse
-	13, // [13:22] is the sub-list for method output_type
-	4,  // [4:13] is the sub-list for method input_type
-	4,  // [4:4] is the sub-list for extension type_name
-	4,  // [4:4] is the sub-list for extension extendee
-	0,  // [0:4] is the sub-list for field type_name
+	42, // 0: pingpong.ListMatchesRequest.from:type_name -> google.protobuf.Timestamp
+	42, // 1: pingpong.ListMatchesRequest.to:type_name -> google.protobuf.Timestamp
+	31, // 2: pingpong.ListMatchesResponse.matches:type_name -> pingpong.Match
+	0,  // 3: pingpong.ExportMatchesRequest.format:type_name -> pingpong.ExportFormat
+	42, // 4: pingpong.ExportMatchesRequest.from:type_name -> google.protobuf.Timestamp
+	42, // 5: pingpong.ExportMatchesRequest.to:type_name -> google.protobuf.Timestamp
+	1,  // 6: pingpong.HitBallRequest.power:type_name -> pingpong.ShotPower
+	2,  // 7: pingpong.HitBallRequest.placement:type_name -> pingpong.ShotPlacement
+	3,  // 8: pingpong.MatchUpdate.kind:type_name -> pingpong.MatchUpdate.Kind
+	42, // 9: pingpong.MatchUpdate.time:type_name -> google.protobuf.Timestamp
+	38, // 10: pingpong.MatchUpdate.turn:type_name -> pingpong.Turn
+	42, // 11: pingpong.MatchUpdate.deadline:type_name -> google.protobuf.Timestamp
+	32, // 12: pingpong.StartGameRequest.game_rules:type_name -> pingpong.GameRules
+	42, // 13: pingpong.Match.start_time:type_name -> google.protobuf.Timestamp
+	42, // 14: pingpong.Match.end_time:type_name -> google.protobuf.Timestamp
+	38, // 15: pingpong.Match.turns:type_name -> pingpong.Turn
+	32, // 16: pingpong.Match.game_rules:type_name -> pingpong.GameRules
+	37, // 17: pingpong.GameRules.serve_power:type_name -> pingpong.PowerRange
+	37, // 18: pingpong.GameRules.return_percent_a:type_name -> pingpong.PowerRange
+	37, // 19: pingpong.GameRules.return_power_b:type_name -> pingpong.PowerRange
+	42, // 20: pingpong.Schedule.create_time:type_name -> google.protobuf.Timestamp
+	33, // 21: pingpong.ListSchedulesResponse.schedules:type_name -> pingpong.Schedule
+	42, // 22: pingpong.Turn.time:type_name -> google.protobuf.Timestamp
+	43, // 23: pingpong.MatchStats.duration:type_name -> google.protobuf.Duration
+	41, // 24: pingpong.MatchStats.player_stats:type_name -> pingpong.MatchStats.PlayerStatsEntry
+	39, // 25: pingpong.MatchStats.PlayerStatsEntry.value:type_name -> pingpong.PlayerStats
+	5,  // 26: pingpong.PlayerService.StartNewMatch:input_type -> pingpong.NewMatchRequest
+	7,  // 27: pingpong.PlayerService.PlayerAPing:input_type -> pingpong.PingRequest
+	7,  // 28: pingpong.PlayerService.PlayerBPing:input_type -> pingpong.PingRequest
+	9,  // 29: pingpong.PlayerService.GetMatch:input_type -> pingpong.GetMatchRequest
+	10, // 30: pingpong.PlayerService.GetMatchByID:input_type -> pingpong.GetMatchByIDRequest
+	11, // 31: pingpong.PlayerService.ListMatches:input_type -> pingpong.ListMatchesRequest
+	31, // 32: pingpong.PlayerService.SaveMatch:input_type -> pingpong.Match
+	14, // 33: pingpong.PlayerService.GetPlayerStats:input_type -> pingpong.GetPlayerStatsRequest
+	16, // 34: pingpong.PlayerService.TestDB:input_type -> pingpong.TestDBRequest
+	44, // 35: pingpong.PlayerService.IsGameActive:input_type -> google.protobuf.Empty
+	18, // 36: pingpong.PlayerService.ExportMatches:input_type -> pingpong.ExportMatchesRequest
+	10, // 37: pingpong.PlayerService.GetMatchStats:input_type -> pingpong.GetMatchByIDRequest
+	20, // 38: pingpong.PlayerService.WatchMatch:input_type -> pingpong.WatchMatchRequest
+	25, // 39: pingpong.PlayerService.ReplayMatch:input_type -> pingpong.ReplayMatchRequest
+	21, // 40: pingpong.PlayerService.PauseMatch:input_type -> pingpong.PauseMatchRequest
+	23, // 41: pingpong.PlayerService.HitBall:input_type -> pingpong.HitBallRequest
+	44, // 42: pingpong.PlayerService.GetGameRules:input_type -> google.protobuf.Empty
+	32, // 43: pingpong.PlayerService.SetGameRules:input_type -> pingpong.GameRules
+	34, // 44: pingpong.PlayerService.CreateSchedule:input_type -> pingpong.CreateScheduleRequest
+	44, // 45: pingpong.PlayerService.ListSchedules:input_type -> google.protobuf.Empty
+	36, // 46: pingpong.PlayerService.DeleteSchedule:input_type -> pingpong.DeleteScheduleRequest
+	27, // 47: pingpong.TableService.StartGame:input_type -> pingpong.StartGameRequest
+	29, // 48: pingpong.TableService.ReceiveBall:input_type -> pingpong.ReceiveBallRequest
+	6,  // 49: pingpong.PlayerService.StartNewMatch:output_type -> pingpong.NewMatchResponse
+	8,  // 50: pingpong.PlayerService.PlayerAPing:output_type -> pingpong.PingResponse
+	8,  // 51: pingpong.PlayerService.PlayerBPing:output_type -> pingpong.PingResponse
+	31, // 52: pingpong.PlayerService.GetMatch:output_type -> pingpong.Match
+	31, // 53: pingpong.PlayerService.GetMatchByID:output_type -> pingpong.Match
+	12, // 54: pingpong.PlayerService.ListMatches:output_type -> pingpong.ListMatchesResponse
+	13, // 55: pingpong.PlayerService.SaveMatch:output_type -> pingpong.SaveMatchResponse
+	15, // 56: pingpong.PlayerService.GetPlayerStats:output_type -> pingpong.PlayerSummary
+	17, // 57: pingpong.PlayerService.TestDB:output_type -> pingpong.TestDBResponse
+	4,  // 58: pingpong.PlayerService.IsGameActive:output_type -> pingpong.IsGameActiveResponse
+	19, // 59: pingpong.PlayerService.ExportMatches:output_type -> pingpong.ExportChunk
+	40, // 60: pingpong.PlayerService.GetMatchStats:output_type -> pingpong.MatchStats
+	26, // 61: pingpong.PlayerService.WatchMatch:output_type -> pingpong.MatchUpdate
+	26, // 62: pingpong.PlayerService.ReplayMatch:output_type -> pingpong.MatchUpdate
+	22, // 63: pingpong.PlayerService.PauseMatch:output_type -> pingpong.PauseMatchResponse
+	24, // 64: pingpong.PlayerService.HitBall:output_type -> pingpong.HitBallResponse
+	32, // 65: pingpong.PlayerService.GetGameRules:output_type -> pingpong.GameRules
+	32, // 66: pingpong.PlayerService.SetGameRules:output_type -> pingpong.GameRules
+	33, // 67: pingpong.PlayerService.CreateSchedule:output_type -> pingpong.Schedule
+	35, // 68: pingpong.PlayerService.ListSchedules:output_type -> pingpong.ListSchedulesResponse
+	44, // 69: pingpong.PlayerService.DeleteSchedule:output_type -> google.protobuf.Empty
+	28, // 70: pingpong.TableService.StartGame:output_type -> pingpong.StartGameResponse
+	30, // 71: pingpong.TableService.ReceiveBall:output_type -> pingpong.ReceiveBallResponse
+	49, // [49:72] is the sub-list for method output_type
+	26, // [26:49] is the sub-list for method input_type
+	26, // [26:26] is the sub-list for extension type_name
+	26, // [26:26] is the sub-list for extension extendee
+	0,  // [0:26] is the sub-list for field type_name
 }
 
 func init() { file_pingpong_proto_init() }
@@ -860,18 +2656,20 @@ func file_pingpong_proto_init() {
 	if File_pingpong_proto != nil {
 		return
 	}
+	file_rules_proto_init()
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_pingpong_proto_rawDesc), len(file_pingpong_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   15,
+			NumEnums:      4,
+			NumMessages:   38,
 			NumExtensions: 0,
 			NumServices:   2,
 		},
 		GoTypes:           file_pingpong_proto_goTypes,
 		DependencyIndexes: file_pingpong_proto_depIdxs,
+		EnumInfos:         file_pingpong_proto_enumTypes,
 		MessageInfos:      file_pingpong_proto_msgTypes,
 	}.Build()
 	File_pingpong_proto = out.File
@@ -22,13 +22,27 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	PlayerService_StartNewMatch_FullMethodName = "/pingpong.PlayerService/StartNewMatch"
-	PlayerService_PlayerAPing_FullMethodName   = "/pingpong.PlayerService/PlayerAPing"
-	PlayerService_PlayerBPing_FullMethodName   = "/pingpong.PlayerService/PlayerBPing"
-	PlayerService_GetMatch_FullMethodName      = "/pingpong.PlayerService/GetMatch"
-	PlayerService_GetMatchByID_FullMethodName  = "/pingpong.PlayerService/GetMatchByID"
-	PlayerService_TestDB_FullMethodName        = "/pingpong.PlayerService/TestDB"
-	PlayerService_IsGameActive_FullMethodName  = "/pingpong.PlayerService/IsGameActive"
+	PlayerService_StartNewMatch_FullMethodName  = "/pingpong.PlayerService/StartNewMatch"
+	PlayerService_PlayerAPing_FullMethodName    = "/pingpong.PlayerService/PlayerAPing"
+	PlayerService_PlayerBPing_FullMethodName    = "/pingpong.PlayerService/PlayerBPing"
+	PlayerService_GetMatch_FullMethodName       = "/pingpong.PlayerService/GetMatch"
+	PlayerService_GetMatchByID_FullMethodName   = "/pingpong.PlayerService/GetMatchByID"
+	PlayerService_ListMatches_FullMethodName    = "/pingpong.PlayerService/ListMatches"
+	PlayerService_SaveMatch_FullMethodName      = "/pingpong.PlayerService/SaveMatch"
+	PlayerService_GetPlayerStats_FullMethodName = "/pingpong.PlayerService/GetPlayerStats"
+	PlayerService_TestDB_FullMethodName         = "/pingpong.PlayerService/TestDB"
+	PlayerService_IsGameActive_FullMethodName   = "/pingpong.PlayerService/IsGameActive"
+	PlayerService_ExportMatches_FullMethodName  = "/pingpong.PlayerService/ExportMatches"
+	PlayerService_GetMatchStats_FullMethodName  = "/pingpong.PlayerService/GetMatchStats"
+	PlayerService_WatchMatch_FullMethodName     = "/pingpong.PlayerService/WatchMatch"
+	PlayerService_ReplayMatch_FullMethodName    = "/pingpong.PlayerService/ReplayMatch"
+	PlayerService_PauseMatch_FullMethodName     = "/pingpong.PlayerService/PauseMatch"
+	PlayerService_HitBall_FullMethodName        = "/pingpong.PlayerService/HitBall"
+	PlayerService_GetGameRules_FullMethodName   = "/pingpong.PlayerService/GetGameRules"
+	PlayerService_SetGameRules_FullMethodName   = "/pingpong.PlayerService/SetGameRules"
+	PlayerService_CreateSchedule_FullMethodName = "/pingpong.PlayerService/CreateSchedule"
+	PlayerService_ListSchedules_FullMethodName  = "/pingpong.PlayerService/ListSchedules"
+	PlayerService_DeleteSchedule_FullMethodName = "/pingpong.PlayerService/DeleteSchedule"
 )
 
 // PlayerServiceClient is the client API for PlayerService service.
@@ -40,8 +54,22 @@ type PlayerServiceClient interface {
 	PlayerBPing(ctx context.Context, in *PingRequest, opts ...grpc.CallOption) (*PingResponse, error)
 	GetMatch(ctx context.Context, in *GetMatchRequest, opts ...grpc.CallOption) (*Match, error)
 	GetMatchByID(ctx context.Context, in *GetMatchByIDRequest, opts ...grpc.CallOption) (*Match, error)
+	ListMatches(ctx context.Context, in *ListMatchesRequest, opts ...grpc.CallOption) (*ListMatchesResponse, error)
+	SaveMatch(ctx context.Context, in *Match, opts ...grpc.CallOption) (*SaveMatchResponse, error)
+	GetPlayerStats(ctx context.Context, in *GetPlayerStatsRequest, opts ...grpc.CallOption) (*PlayerSummary, error)
 	TestDB(ctx context.Context, in *TestDBRequest, opts ...grpc.CallOption) (*TestDBResponse, error)
 	IsGameActive(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*IsGameActiveResponse, error)
+	ExportMatches(ctx context.Context, in *ExportMatchesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExportChunk], error)
+	GetMatchStats(ctx context.Context, in *GetMatchByIDRequest, opts ...grpc.CallOption) (*MatchStats, error)
+	WatchMatch(ctx context.Context, in *WatchMatchRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[MatchUpdate], error)
+	ReplayMatch(ctx context.Context, in *ReplayMatchRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[MatchUpdate], error)
+	PauseMatch(ctx context.Context, in *PauseMatchRequest, opts ...grpc.CallOption) (*PauseMatchResponse, error)
+	HitBall(ctx context.Context, in *HitBallRequest, opts ...grpc.CallOption) (*HitBallResponse, error)
+	GetGameRules(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GameRules, error)
+	SetGameRules(ctx context.Context, in *GameRules, opts ...grpc.CallOption) (*GameRules, error)
+	CreateSchedule(ctx context.Context, in *CreateScheduleRequest, opts ...grpc.CallOption) (*Schedule, error)
+	ListSchedules(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListSchedulesResponse, error)
+	DeleteSchedule(ctx context.Context, in *DeleteScheduleRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 }
 
 type playerServiceClient struct {
@@ -102,6 +130,36 @@ func (c *playerServiceClient) GetMatchByID(ctx context.Context, in *GetMatchByID
 	return out, nil
 }
 
+func (c *playerServiceClient) ListMatches(ctx context.Context, in *ListMatchesRequest, opts ...grpc.CallOption) (*ListMatchesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListMatchesResponse)
+	err := c.cc.Invoke(ctx, PlayerService_ListMatches_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *playerServiceClient) SaveMatch(ctx context.Context, in *Match, opts ...grpc.CallOption) (*SaveMatchResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SaveMatchResponse)
+	err := c.cc.Invoke(ctx, PlayerService_SaveMatch_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *playerServiceClient) GetPlayerStats(ctx context.Context, in *GetPlayerStatsRequest, opts ...grpc.CallOption) (*PlayerSummary, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PlayerSummary)
+	err := c.cc.Invoke(ctx, PlayerService_GetPlayerStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *playerServiceClient) TestDB(ctx context.Context, in *TestDBRequest, opts ...grpc.CallOption) (*TestDBResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(TestDBResponse)
@@ -122,6 +180,143 @@ func (c *playerServiceClient) IsGameActive(ctx context.Context, in *emptypb.Empt
 	return out, nil
 }
 
+func (c *playerServiceClient) ExportMatches(ctx context.Context, in *ExportMatchesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExportChunk], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &PlayerService_ServiceDesc.Streams[0], PlayerService_ExportMatches_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ExportMatchesRequest, ExportChunk]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type PlayerService_ExportMatchesClient = grpc.ServerStreamingClient[ExportChunk]
+
+func (c *playerServiceClient) GetMatchStats(ctx context.Context, in *GetMatchByIDRequest, opts ...grpc.CallOption) (*MatchStats, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MatchStats)
+	err := c.cc.Invoke(ctx, PlayerService_GetMatchStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *playerServiceClient) WatchMatch(ctx context.Context, in *WatchMatchRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[MatchUpdate], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &PlayerService_ServiceDesc.Streams[1], PlayerService_WatchMatch_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchMatchRequest, MatchUpdate]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type PlayerService_WatchMatchClient = grpc.ServerStreamingClient[MatchUpdate]
+
+func (c *playerServiceClient) ReplayMatch(ctx context.Context, in *ReplayMatchRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[MatchUpdate], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &PlayerService_ServiceDesc.Streams[2], PlayerService_ReplayMatch_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ReplayMatchRequest, MatchUpdate]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type PlayerService_ReplayMatchClient = grpc.ServerStreamingClient[MatchUpdate]
+
+func (c *playerServiceClient) PauseMatch(ctx context.Context, in *PauseMatchRequest, opts ...grpc.CallOption) (*PauseMatchResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PauseMatchResponse)
+	err := c.cc.Invoke(ctx, PlayerService_PauseMatch_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *playerServiceClient) HitBall(ctx context.Context, in *HitBallRequest, opts ...grpc.CallOption) (*HitBallResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(HitBallResponse)
+	err := c.cc.Invoke(ctx, PlayerService_HitBall_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *playerServiceClient) GetGameRules(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GameRules, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GameRules)
+	err := c.cc.Invoke(ctx, PlayerService_GetGameRules_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *playerServiceClient) SetGameRules(ctx context.Context, in *GameRules, opts ...grpc.CallOption) (*GameRules, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GameRules)
+	err := c.cc.Invoke(ctx, PlayerService_SetGameRules_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *playerServiceClient) CreateSchedule(ctx context.Context, in *CreateScheduleRequest, opts ...grpc.CallOption) (*Schedule, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Schedule)
+	err := c.cc.Invoke(ctx, PlayerService_CreateSchedule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *playerServiceClient) ListSchedules(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListSchedulesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSchedulesResponse)
+	err := c.cc.Invoke(ctx, PlayerService_ListSchedules_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *playerServiceClient) DeleteSchedule(ctx context.Context, in *DeleteScheduleRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, PlayerService_DeleteSchedule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // PlayerServiceServer is the server API for PlayerService service.
 // All implementations must embed UnimplementedPlayerServiceServer
 // for forward compatibility.
@@ -131,8 +326,22 @@ type PlayerServiceServer interface {
 	PlayerBPing(context.Context, *PingRequest) (*PingResponse, error)
 	GetMatch(context.Context, *GetMatchRequest) (*Match, error)
 	GetMatchByID(context.Context, *GetMatchByIDRequest) (*Match, error)
+	ListMatches(context.Context, *ListMatchesRequest) (*ListMatchesResponse, error)
+	SaveMatch(context.Context, *Match) (*SaveMatchResponse, error)
+	GetPlayerStats(context.Context, *GetPlayerStatsRequest) (*PlayerSummary, error)
 	TestDB(context.Context, *TestDBRequest) (*TestDBResponse, error)
 	IsGameActive(context.Context, *emptypb.Empty) (*IsGameActiveResponse, error)
+	ExportMatches(*ExportMatchesRequest, grpc.ServerStreamingServer[ExportChunk]) error
+	GetMatchStats(context.Context, *GetMatchByIDRequest) (*MatchStats, error)
+	WatchMatch(*WatchMatchRequest, grpc.ServerStreamingServer[MatchUpdate]) error
+	ReplayMatch(*ReplayMatchRequest, grpc.ServerStreamingServer[MatchUpdate]) error
+	PauseMatch(context.Context, *PauseMatchRequest) (*PauseMatchResponse, error)
+	HitBall(context.Context, *HitBallRequest) (*HitBallResponse, error)
+	GetGameRules(context.Context, *emptypb.Empty) (*GameRules, error)
+	SetGameRules(context.Context, *GameRules) (*GameRules, error)
+	CreateSchedule(context.Context, *CreateScheduleRequest) (*Schedule, error)
+	ListSchedules(context.Context, *emptypb.Empty) (*ListSchedulesResponse, error)
+	DeleteSchedule(context.Context, *DeleteScheduleRequest) (*emptypb.Empty, error)
 	mustEmbedUnimplementedPlayerServiceServer()
 }
 
@@ -158,12 +367,54 @@ func (UnimplementedPlayerServiceServer) GetMatch(context.Context, *GetMatchReque
 func (UnimplementedPlayerServiceServer) GetMatchByID(context.Context, *GetMatchByIDRequest) (*Match, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetMatchByID not implemented")
 }
+func (UnimplementedPlayerServiceServer) ListMatches(context.Context, *ListMatchesRequest) (*ListMatchesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMatches not implemented")
+}
+func (UnimplementedPlayerServiceServer) SaveMatch(context.Context, *Match) (*SaveMatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SaveMatch not implemented")
+}
+func (UnimplementedPlayerServiceServer) GetPlayerStats(context.Context, *GetPlayerStatsRequest) (*PlayerSummary, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPlayerStats not implemented")
+}
 func (UnimplementedPlayerServiceServer) TestDB(context.Context, *TestDBRequest) (*TestDBResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TestDB not implemented")
 }
 func (UnimplementedPlayerServiceServer) IsGameActive(context.Context, *emptypb.Empty) (*IsGameActiveResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method IsGameActive not implemented")
 }
+func (UnimplementedPlayerServiceServer) ExportMatches(*ExportMatchesRequest, grpc.ServerStreamingServer[ExportChunk]) error {
+	return status.Errorf(codes.Unimplemented, "method ExportMatches not implemented")
+}
+func (UnimplementedPlayerServiceServer) GetMatchStats(context.Context, *GetMatchByIDRequest) (*MatchStats, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMatchStats not implemented")
+}
+func (UnimplementedPlayerServiceServer) WatchMatch(*WatchMatchRequest, grpc.ServerStreamingServer[MatchUpdate]) error {
+	return status.Errorf(codes.Unimplemented, "method WatchMatch not implemented")
+}
+func (UnimplementedPlayerServiceServer) ReplayMatch(*ReplayMatchRequest, grpc.ServerStreamingServer[MatchUpdate]) error {
+	return status.Errorf(codes.Unimplemented, "method ReplayMatch not implemented")
+}
+func (UnimplementedPlayerServiceServer) PauseMatch(context.Context, *PauseMatchRequest) (*PauseMatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseMatch not implemented")
+}
+func (UnimplementedPlayerServiceServer) HitBall(context.Context, *HitBallRequest) (*HitBallResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HitBall not implemented")
+}
+func (UnimplementedPlayerServiceServer) GetGameRules(context.Context, *emptypb.Empty) (*GameRules, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGameRules not implemented")
+}
+func (UnimplementedPlayerServiceServer) SetGameRules(context.Context, *GameRules) (*GameRules, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetGameRules not implemented")
+}
+func (UnimplementedPlayerServiceServer) CreateSchedule(context.Context, *CreateScheduleRequest) (*Schedule, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSchedule not implemented")
+}
+func (UnimplementedPlayerServiceServer) ListSchedules(context.Context, *emptypb.Empty) (*ListSchedulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSchedules not implemented")
+}
+func (UnimplementedPlayerServiceServer) DeleteSchedule(context.Context, *DeleteScheduleRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSchedule not implemented")
+}
 func (UnimplementedPlayerServiceServer) mustEmbedUnimplementedPlayerServiceServer() {}
 func (UnimplementedPlayerServiceServer) testEmbeddedByValue()                       {}
 
@@ -275,6 +526,60 @@ func _PlayerService_GetMatchByID_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PlayerService_ListMatches_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMatchesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlayerServiceServer).ListMatches(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PlayerService_ListMatches_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlayerServiceServer).ListMatches(ctx, req.(*ListMatchesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PlayerService_SaveMatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Match)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlayerServiceServer).SaveMatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PlayerService_SaveMatch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlayerServiceServer).SaveMatch(ctx, req.(*Match))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PlayerService_GetPlayerStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPlayerStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlayerServiceServer).GetPlayerStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PlayerService_GetPlayerStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlayerServiceServer).GetPlayerStats(ctx, req.(*GetPlayerStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _PlayerService_TestDB_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(TestDBRequest)
 	if err := dec(in); err != nil {
@@ -311,6 +616,183 @@ func _PlayerService_IsGameActive_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PlayerService_ExportMatches_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportMatchesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(PlayerServiceServer).ExportMatches(m, &grpc.GenericServerStream[ExportMatchesRequest, ExportChunk]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type PlayerService_ExportMatchesServer = grpc.ServerStreamingServer[ExportChunk]
+
+func _PlayerService_GetMatchStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMatchByIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlayerServiceServer).GetMatchStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PlayerService_GetMatchStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlayerServiceServer).GetMatchStats(ctx, req.(*GetMatchByIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PlayerService_WatchMatch_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchMatchRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(PlayerServiceServer).WatchMatch(m, &grpc.GenericServerStream[WatchMatchRequest, MatchUpdate]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type PlayerService_WatchMatchServer = grpc.ServerStreamingServer[MatchUpdate]
+
+func _PlayerService_ReplayMatch_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ReplayMatchRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(PlayerServiceServer).ReplayMatch(m, &grpc.GenericServerStream[ReplayMatchRequest, MatchUpdate]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type PlayerService_ReplayMatchServer = grpc.ServerStreamingServer[MatchUpdate]
+
+func _PlayerService_PauseMatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PauseMatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlayerServiceServer).PauseMatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PlayerService_PauseMatch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlayerServiceServer).PauseMatch(ctx, req.(*PauseMatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PlayerService_HitBall_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HitBallRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlayerServiceServer).HitBall(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PlayerService_HitBall_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlayerServiceServer).HitBall(ctx, req.(*HitBallRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PlayerService_GetGameRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlayerServiceServer).GetGameRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PlayerService_GetGameRules_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlayerServiceServer).GetGameRules(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PlayerService_SetGameRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GameRules)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlayerServiceServer).SetGameRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PlayerService_SetGameRules_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlayerServiceServer).SetGameRules(ctx, req.(*GameRules))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PlayerService_CreateSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlayerServiceServer).CreateSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PlayerService_CreateSchedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlayerServiceServer).CreateSchedule(ctx, req.(*CreateScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PlayerService_ListSchedules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlayerServiceServer).ListSchedules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PlayerService_ListSchedules_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlayerServiceServer).ListSchedules(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PlayerService_DeleteSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlayerServiceServer).DeleteSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PlayerService_DeleteSchedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlayerServiceServer).DeleteSchedule(ctx, req.(*DeleteScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // PlayerService_ServiceDesc is the grpc.ServiceDesc for PlayerService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -338,6 +820,18 @@ var PlayerService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetMatchByID",
 			Handler:    _PlayerService_GetMatchByID_Handler,
 		},
+		{
+			MethodName: "ListMatches",
+			Handler:    _PlayerService_ListMatches_Handler,
+		},
+		{
+			MethodName: "SaveMatch",
+			Handler:    _PlayerService_SaveMatch_Handler,
+		},
+		{
+			MethodName: "GetPlayerStats",
+			Handler:    _PlayerService_GetPlayerStats_Handler,
+		},
 		{
 			MethodName: "TestDB",
 			Handler:    _PlayerService_TestDB_Handler,
@@ -346,8 +840,56 @@ var PlayerService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "IsGameActive",
 			Handler:    _PlayerService_IsGameActive_Handler,
 		},
+		{
+			MethodName: "GetMatchStats",
+			Handler:    _PlayerService_GetMatchStats_Handler,
+		},
+		{
+			MethodName: "PauseMatch",
+			Handler:    _PlayerService_PauseMatch_Handler,
+		},
+		{
+			MethodName: "HitBall",
+			Handler:    _PlayerService_HitBall_Handler,
+		},
+		{
+			MethodName: "GetGameRules",
+			Handler:    _PlayerService_GetGameRules_Handler,
+		},
+		{
+			MethodName: "SetGameRules",
+			Handler:    _PlayerService_SetGameRules_Handler,
+		},
+		{
+			MethodName: "CreateSchedule",
+			Handler:    _PlayerService_CreateSchedule_Handler,
+		},
+		{
+			MethodName: "ListSchedules",
+			Handler:    _PlayerService_ListSchedules_Handler,
+		},
+		{
+			MethodName: "DeleteSchedule",
+			Handler:    _PlayerService_DeleteSchedule_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ExportMatches",
+			Handler:       _PlayerService_ExportMatches_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "WatchMatch",
+			Handler:       _PlayerService_WatchMatch_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ReplayMatch",
+			Handler:       _PlayerService_ReplayMatch_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "pingpong.proto",
 }
 
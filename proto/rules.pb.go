@@ -0,0 +1,206 @@
+// rules.proto
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v6.30.2
+// source: rules.proto
+
+package proto
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	descriptorpb "google.golang.org/protobuf/types/descriptorpb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// FieldRules declares what values a request field accepts. They are checked
+// by the server's validation interceptor for every RPC, including fields of
+// nested and repeated messages.
+type FieldRules struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Inclusive bounds for numeric fields.
+	Min *float64 `protobuf:"fixed64,1,opt,name=min,proto3,oneof" json:"min,omitempty"`
+	Max *float64 `protobuf:"fixed64,2,opt,name=max,proto3,oneof" json:"max,omitempty"`
+	// Allowed values for string fields.
+	In []string `protobuf:"bytes,3,rep,name=in,proto3" json:"in,omitempty"`
+	// Strings must be non-empty, enums non-zero and messages set.
+	Required bool `protobuf:"varint,4,opt,name=required,proto3" json:"required,omitempty"`
+	// Maximum length of string fields.
+	MaxLen *uint32 `protobuf:"varint,5,opt,name=max_len,json=maxLen,proto3,oneof" json:"max_len,omitempty"`
+	// Enum fields must hold one of the declared values.
+	DefinedOnly   bool `protobuf:"varint,6,opt,name=defined_only,json=definedOnly,proto3" json:"defined_only,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FieldRules) Reset() {
+	*x = FieldRules{}
+	mi := &file_rules_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FieldRules) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FieldRules) ProtoMessage() {}
+
+func (x *FieldRules) ProtoReflect() protoreflect.Message {
+	mi := &file_rules_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FieldRules.ProtoReflect.Descriptor instead.
+func (*FieldRules) Descriptor() ([]byte, []int) {
+	return file_rules_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *FieldRules) GetMin() float64 {
+	if x != nil && x.Min != nil {
+		return *x.Min
+	}
+	return 0
+}
+
+func (x *FieldRules) GetMax() float64 {
+	if x != nil && x.Max != nil {
+		return *x.Max
+	}
+	return 0
+}
+
+func (x *FieldRules) GetIn() []string {
+	if x != nil {
+		return x.In
+	}
+	return nil
+}
+
+func (x *FieldRules) GetRequired() bool {
+	if x != nil {
+		return x.Required
+	}
+	return false
+}
+
+func (x *FieldRules) GetMaxLen() uint32 {
+	if x != nil && x.MaxLen != nil {
+		return *x.MaxLen
+	}
+	return 0
+}
+
+func (x *FieldRules) GetDefinedOnly() bool {
+	if x != nil {
+		return x.DefinedOnly
+	}
+	return false
+}
+
+var file_rules_proto_extTypes = []protoimpl.ExtensionInfo{
+	{
+		ExtendedType:  (*descriptorpb.FieldOptions)(nil),
+		ExtensionType: (*FieldRules)(nil),
+		Field:         50001,
+		Name:          "pingpong.rules",
+		Tag:           "bytes,50001,opt,name=rules",
+		Filename:      "rules.proto",
+	},
+}
+
+// Extension fields to descriptorpb.FieldOptions.
+var (
+	// optional pingpong.FieldRules rules = 50001;
+	E_Rules = &file_rules_proto_extTypes[0]
+)
+
+var File_rules_proto protoreflect.FileDescriptor
+
+const file_rules_proto_rawDesc = "" +
+	"\n" +
+	"\vrules.proto\x12\bpingpong\x1a google/protobuf/descriptor.proto\"\xc3\x01\n" +
+	"\n" +
+	"FieldRules\x12\x15\n" +
+	"\x03min\x18\x01 \x01(\x01H\x00R\x03min\x88\x01\x01\x12\x15\n" +
+	"\x03max\x18\x02 \x01(\x01H\x01R\x03max\x88\x01\x01\x12\x0e\n" +
+	"\x02in\x18\x03 \x03(\tR\x02in\x12\x1a\n" +
+	"\brequired\x18\x04 \x01(\bR\brequired\x12\x1c\n" +
+	"\amax_len\x18\x05 \x01(\rH\x02R\x06maxLen\x88\x01\x01\x12!\n" +
+	"\fdefined_only\x18\x06 \x01(\bR\vdefinedOnlyB\x06\n" +
+	"\x04_minB\x06\n" +
+	"\x04_maxB\n" +
+	"\n" +
+	"\b_max_len:K\n" +
+	"\x05rules\x12\x1d.google.protobuf.FieldOptions\x18ц\x03 \x01(\v2\x14.pingpong.FieldRulesR\x05rulesB\x10Z\x0epingpong/protob\x06proto3"
+
+var (
+	file_rules_proto_rawDescOnce sync.Once
+	file_rules_proto_rawDescData []byte
+)
+
+func file_rules_proto_rawDescGZIP() []byte {
+	file_rules_proto_rawDescOnce.Do(func() {
+		file_rules_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_rules_proto_rawDesc), len(file_rules_proto_rawDesc)))
+	})
+	return file_rules_proto_rawDescData
+}
+
+var file_rules_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_rules_proto_goTypes = []any{
+	(*FieldRules)(nil),                // 0: pingpong.FieldRules
+	(*descriptorpb.FieldOptions)(nil), // 1: google.protobuf.FieldOptions
+}
+var file_rules_proto_depIdxs = []int32{
+	1, // 0: pingpong.rules:extendee -> google.protobuf.FieldOptions
+	0, // 1: pingpong.rules:type_name -> pingpong.FieldRules
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	1, // [1:2] is the sub-list for extension type_name
+	0, // [0:1] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_rules_proto_init() }
+func file_rules_proto_init() {
+	if File_rules_proto != nil {
+		return
+	}
+	file_rules_proto_msgTypes[0].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_rules_proto_rawDesc), len(file_rules_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 1,
+			NumServices:   0,
+		},
+		GoTypes:           file_rules_proto_goTypes,
+		DependencyIndexes: file_rules_proto_depIdxs,
+		MessageInfos:      file_rules_proto_msgTypes,
+		ExtensionInfos:    file_rules_proto_extTypes,
+	}.Build()
+	File_rules_proto = out.File
+	file_rules_proto_goTypes = nil
+	file_rules_proto_depIdxs = nil
+}
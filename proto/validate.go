@@ -0,0 +1,121 @@
+package proto
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	protobuf "google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// Validate checks msg against the (rules) options declared in rules.proto and
+// returns one violation per failing field. Nested and repeated messages are
+// checked as well, with paths such as "turns[2].player".
+func Validate(msg protobuf.Message) []*errdetails.BadRequest_FieldViolation {
+	if msg == nil {
+		return nil
+	}
+	return validateMessage(msg.ProtoReflect(), "")
+}
+
+func validateMessage(m protoreflect.Message, prefix string) []*errdetails.BadRequest_FieldViolation {
+	var violations []*errdetails.BadRequest_FieldViolation
+	fields := m.Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		path := prefix + string(fd.Name())
+
+		if rules, ok := protobuf.GetExtension(fd.Options(), E_Rules).(*FieldRules); ok && rules != nil {
+			violations = append(violations, checkField(m, fd, rules, path)...)
+		}
+
+		if fd.Message() == nil || fd.IsMap() || !m.Has(fd) {
+			continue
+		}
+		if fd.IsList() {
+			list := m.Get(fd).List()
+			for j := 0; j < list.Len(); j++ {
+				violations = append(violations, validateMessage(list.Get(j).Message(), fmt.Sprintf("%s[%d].", path, j))...)
+			}
+			continue
+		}
+		violations = append(violations, validateMessage(m.Get(fd).Message(), path+".")...)
+	}
+	return violations
+}
+
+func checkField(m protoreflect.Message, fd protoreflect.FieldDescriptor, rules *FieldRules, path string) []*errdetails.BadRequest_FieldViolation {
+	if fd.IsList() || fd.IsMap() {
+		return nil
+	}
+
+	var problems []string
+	value := m.Get(fd)
+	switch fd.Kind() {
+	case protoreflect.StringKind:
+		s := value.String()
+		if rules.GetRequired() && s == "" {
+			problems = append(problems, "is required")
+		}
+		if len(rules.GetIn()) > 0 && !slices.Contains(rules.GetIn(), s) {
+			problems = append(problems, fmt.Sprintf("must be one of %s", quoteAll(rules.GetIn())))
+		}
+		if rules.MaxLen != nil && uint32(len(s)) > rules.GetMaxLen() {
+			problems = append(problems, fmt.Sprintf("must be at most %d characters", rules.GetMaxLen()))
+		}
+	case protoreflect.EnumKind:
+		if rules.GetRequired() && value.Enum() == 0 {
+			problems = append(problems, "is required")
+		}
+		if rules.GetDefinedOnly() && fd.Enum().Values().ByNumber(value.Enum()) == nil {
+			problems = append(problems, fmt.Sprintf("must be a defined %s value", fd.Enum().Name()))
+		}
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		if rules.GetRequired() && !m.Has(fd) {
+			problems = append(problems, "is required")
+		}
+	default:
+		if n, ok := number(fd.Kind(), value); ok {
+			if rules.Min != nil && n < rules.GetMin() {
+				problems = append(problems, fmt.Sprintf("must be at least %g", rules.GetMin()))
+			}
+			if rules.Max != nil && n > rules.GetMax() {
+				problems = append(problems, fmt.Sprintf("must be at most %g", rules.GetMax()))
+			}
+		}
+	}
+
+	violations := make([]*errdetails.BadRequest_FieldViolation, 0, len(problems))
+	for _, problem := range problems {
+		violations = append(violations, &errdetails.BadRequest_FieldViolation{
+			Field:       path,
+			Description: path + " " + problem,
+		})
+	}
+	return violations
+}
+
+func number(kind protoreflect.Kind, value protoreflect.Value) (float64, bool) {
+	switch kind {
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind,
+		protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		return float64(value.Int()), true
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind,
+		protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		return float64(value.Uint()), true
+	case protoreflect.FloatKind, protoreflect.DoubleKind:
+		return value.Float(), true
+	default:
+		return 0, false
+	}
+}
+
+func quoteAll(values []string) string {
+	quoted := make([]string, len(values))
+	for i, v := range values {
+		quoted[i] = fmt.Sprintf("%q", v)
+	}
+	return strings.Join(quoted, ", ")
+}
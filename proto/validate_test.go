@@ -0,0 +1,67 @@
+package proto
+
+import (
+	"slices"
+	"strings"
+	"testing"
+
+	protobuf "google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestValidate(t *testing.T) {
+	now := timestamppb.Now()
+	validRange := func() *PowerRange { return &PowerRange{Min: 50, Max: 90} }
+	validTurn := &Turn{TurnNumber: 1, Time: now, Player: "A", BallPower: 80}
+
+	tests := []struct {
+		name string
+		msg  protobuf.Message
+		want []string // fields with violations, in order
+	}{
+		{"valid", &PingRequest{BallPower: 50}, nil},
+		{"below min", &PingRequest{BallPower: -1}, []string{"ball_power"}},
+		{"above max", &PingRequest{BallPower: 101}, []string{"ball_power"}},
+		{"double above max", &ReplayMatchRequest{MatchId: 1, Speed: 100.5}, []string{"speed"}},
+		{"not in list", &ReceiveBallRequest{BallPower: 50, FromPlayer: "C"}, []string{"from_player"}},
+		{"in list", &ReceiveBallRequest{BallPower: 50, FromPlayer: "B"}, nil},
+		{"required string", &GetPlayerStatsRequest{}, []string{"player_id"}},
+		{"too long", &GetPlayerStatsRequest{PlayerId: "abcdefghijk"}, []string{"player_id"}},
+		{"required enum", &HitBallRequest{}, []string{"power"}},
+		{"undefined enums", &HitBallRequest{Power: ShotPower(9), Placement: ShotPlacement(7)}, []string{"power", "placement"}},
+		{"defined enum", &ExportMatchesRequest{Format: ExportFormat_EXPORT_FORMAT_CSV}, nil},
+		{"undefined enum", &ExportMatchesRequest{Format: ExportFormat(5)}, []string{"format"}},
+		{"required messages", &GameRules{TurnLimit: 1}, []string{"serve_power", "return_percent_a", "return_power_b"}},
+		{
+			"nested message",
+			&GameRules{TurnLimit: 1, ServePower: &PowerRange{Min: -1, Max: 90}, ReturnPercentA: validRange(), ReturnPowerB: validRange()},
+			[]string{"serve_power.min"},
+		},
+		{
+			"repeated message",
+			&Match{MatchNumber: 1, StartTime: now, Turns: []*Turn{validTurn, {TurnNumber: 2, Time: now, Player: "C"}}},
+			[]string{"turns[1].player"},
+		},
+		{"several rules", &CreateScheduleRequest{}, []string{"name", "cron", "matches"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []string
+			for _, v := range Validate(tt.msg) {
+				got = append(got, v.Field)
+				if !strings.HasPrefix(v.Description, v.Field+" ") {
+					t.Errorf("description %q does not start with the field %q", v.Description, v.Field)
+				}
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("violations on %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidateNil(t *testing.T) {
+	if violations := Validate(nil); violations != nil {
+		t.Errorf("Validate(nil) = %v, want none", violations)
+	}
+}
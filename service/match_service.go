@@ -2,38 +2,278 @@ package service
 
 import (
 	"context"
-	"log"
+	"fmt"
+	"time"
 
 	"pingpong/domain"
+	"pingpong/logging"
 	"pingpong/ports"
 )
 
+var logger = logging.For(logging.ComponentService)
+
 type matchService struct {
-	repo ports.MatchRepository
+	repo      ports.MatchRepository
+	publisher ports.EventPublisher
 }
 
-func NewMatchService(repo ports.MatchRepository) ports.MatchService {
+// NewMatchService creates the service. publisher may be nil when events do
+// not need to leave the process.
+func NewMatchService(repo ports.MatchRepository, publisher ports.EventPublisher) ports.MatchService {
 	return &matchService{
-		repo: repo,
+		repo:      repo,
+		publisher: publisher,
 	}
 }
 
 func (s *matchService) SaveMatch(ctx context.Context, match domain.Match) error {
-	log.Printf("Service: Saving match #%d", match.MatchNumber)
+	logger.DebugContext(ctx, "Saving match", "match_number", match.MatchNumber)
 	return s.repo.SaveMatch(ctx, match)
 }
 
+// ImportMatch saves a match reconstructed from a turn log unless a match with
+// the same routine ID is already stored. It reports whether the match was saved.
+func (s *matchService) ImportMatch(ctx context.Context, match domain.Match) (bool, error) {
+	if len(match.Turns) == 0 {
+		return false, fmt.Errorf("match #%d has no turns: %w", match.MatchNumber, domain.ErrInvalidArgument)
+	}
+
+	routineID := match.Turns[0].RoutineID
+	exists, err := s.repo.MatchExistsByRoutineID(ctx, routineID)
+	if err != nil {
+		return false, err
+	}
+	if exists {
+		logger.InfoContext(ctx, "Skipping match, already imported", "routine_id", routineID)
+		return false, nil
+	}
+
+	logger.InfoContext(ctx, "Importing match", "routine_id", routineID)
+	return true, s.repo.SaveMatch(ctx, match)
+}
+
+// RecordEvent appends an event to the match's history and then applies it to
+// the matches/turns tables, which are kept as a read model. A MatchStarted
+// event creates the match row, so it is the only event that may be recorded
+// without a match ID.
+//
+// The event is published whether or not it could be stored, so other
+// services keep following matches while the database is down. It is returned
+// as far as it got: without a sequence number when it was not appended, and
+// without a match ID when the match itself was not created.
+func (s *matchService) RecordEvent(ctx context.Context, event domain.Event) (domain.Event, error) {
+	return s.recordEvent(ctx, event, nil)
+}
+
+// FinishMatch records a MatchFinished event like RecordEvent. Any of turns
+// that has no event yet, because it could not be appended while the match was
+// played, is appended first so the stored match keeps every turn.
+func (s *matchService) FinishMatch(ctx context.Context, event domain.Event, turns []domain.Turn) (domain.Event, error) {
+	if event.Type != domain.EventMatchFinished {
+		return event, fmt.Errorf("cannot finish a match with a %s event: %w", event.Type, domain.ErrInvalidArgument)
+	}
+	return s.recordEvent(ctx, event, turns)
+}
+
+func (s *matchService) recordEvent(ctx context.Context, event domain.Event, turns []domain.Turn) (domain.Event, error) {
+	logger.DebugContext(ctx, "Recording event", "event", event.Type, "id", event.MatchID)
+
+	event, err := s.storeEvent(ctx, event, turns)
+	if s.publisher != nil {
+		if err := s.publisher.Publish(ctx, event); err != nil {
+			logger.ErrorContext(ctx, "Failed to publish event", "event", event.Type, "id", event.MatchID, "err", err)
+		}
+	}
+	return event, err
+}
+
+// storeEvent appends the event and applies it to the read model in one
+// transaction, so neither is stored without the other.
+func (s *matchService) storeEvent(ctx context.Context, event domain.Event, turns []domain.Turn) (domain.Event, error) {
+	if event.Type != domain.EventMatchStarted && event.MatchID == 0 {
+		return event, fmt.Errorf("%s event has no match ID: %w", event.Type, domain.ErrInvalidArgument)
+	}
+
+	var stored domain.Event
+	err := s.repo.Transaction(ctx, func(repo ports.MatchRepository) error {
+		if event.Type == domain.EventMatchStarted {
+			matchID, err := repo.CreateMatch(ctx, domain.Match{
+				MatchNumber: event.Payload.MatchNumber,
+				StartTime:   event.Time,
+				Rules:       event.Payload.Rules,
+			})
+			if err != nil {
+				return err
+			}
+			event.MatchID = matchID
+		}
+		if event.Type == domain.EventMatchFinished {
+			if err := restoreTurns(ctx, repo, event.MatchID, turns); err != nil {
+				return err
+			}
+		}
+
+		var err error
+		if stored, err = repo.AppendEvent(ctx, event); err != nil {
+			return err
+		}
+		switch {
+		case stored.IsTurn():
+			return repo.AppendTurn(ctx, stored.MatchID, stored.Turn())
+		case stored.Type == domain.EventMatchFinished:
+			return repo.FinishMatch(ctx, stored.MatchID, stored.Time, stored.Payload.Winner)
+		}
+		return nil
+	})
+	if err != nil {
+		if event.Type == domain.EventMatchStarted {
+			event.MatchID = 0
+		}
+		return event, err
+	}
+	return stored, nil
+}
+
+// restoreTurns appends the events and turn rows of the turns that have no
+// event in the match's history.
+func restoreTurns(ctx context.Context, repo ports.MatchRepository, matchID int, turns []domain.Turn) error {
+	if len(turns) == 0 {
+		return nil
+	}
+
+	events, err := repo.ListEvents(ctx, matchID)
+	if err != nil {
+		return err
+	}
+	recorded := make(map[int]bool, len(events))
+	for _, e := range events {
+		if e.IsTurn() {
+			recorded[e.Payload.TurnNumber] = true
+		}
+	}
+
+	for _, turn := range turns {
+		if recorded[turn.TurnNumber] {
+			continue
+		}
+		logger.WarnContext(ctx, "Restoring turn missing from the match history", "id", matchID, "turn", turn.TurnNumber)
+		stored, err := repo.AppendEvent(ctx, domain.TurnEvent(matchID, turn))
+		if err != nil {
+			return err
+		}
+		if err := repo.AppendTurn(ctx, matchID, stored.Turn()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (s *matchService) GetMatchByID(ctx context.Context, id int) (domain.Match, error) {
-	log.Printf("Service: Getting match with ID %d", id)
-	return s.repo.GetMatchByID(ctx, id)
+	logger.DebugContext(ctx, "Getting match", "id", id)
+	if id <= 0 {
+		return domain.Match{}, fmt.Errorf("match ID %d: %w", id, domain.ErrInvalidArgument)
+	}
+
+	match, err := s.repo.GetMatchByID(ctx, id)
+	if err != nil {
+		return domain.Match{}, err
+	}
+	return s.projectMatch(ctx, match)
 }
 
 func (s *matchService) GetLastMatch(ctx context.Context) (domain.Match, error) {
-	log.Println("Service: Getting last match")
-	return s.repo.GetLastMatch(ctx)
+	logger.DebugContext(ctx, "Getting last match")
+
+	match, err := s.repo.GetLastMatch(ctx)
+	if err != nil {
+		return domain.Match{}, err
+	}
+	return s.projectMatch(ctx, match)
 }
 
-func (s *matchService) TestConnection(ctx context.Context) error {
-	log.Println("Service: Testing database connection")
-	return s.repo.TestConnection(ctx)
-}
\ No newline at end of file
+// projectMatch rebuilds the match from its events. Matches saved before event
+// sourcing, or imported from turn logs, have no MatchStarted event and are
+// returned as stored.
+func (s *matchService) projectMatch(ctx context.Context, stored domain.Match) (domain.Match, error) {
+	events, err := s.repo.ListEvents(ctx, stored.ID)
+	if err != nil {
+		return domain.Match{}, err
+	}
+	if len(events) == 0 || events[0].Type != domain.EventMatchStarted {
+		return stored, nil
+	}
+
+	return domain.ProjectMatch(events), nil
+}
+
+func (s *matchService) GetMatchStats(ctx context.Context, id int) (domain.MatchStats, error) {
+	logger.DebugContext(ctx, "Getting match stats", "id", id)
+	if id <= 0 {
+		return domain.MatchStats{}, fmt.Errorf("match ID %d: %w", id, domain.ErrInvalidArgument)
+	}
+
+	events, err := s.repo.ListEvents(ctx, id)
+	if err != nil {
+		return domain.MatchStats{}, err
+	}
+	if len(events) == 0 {
+		return domain.MatchStats{}, fmt.Errorf("match with ID %d has no recorded events: %w", id, domain.ErrMatchNotFound)
+	}
+
+	return domain.ProjectStats(events), nil
+}
+
+func (s *matchService) StreamMatches(ctx context.Context, filter domain.MatchFilter, fn func(domain.Match) error) error {
+	logger.DebugContext(ctx, "Streaming matches", "from", filter.From, "to", filter.To, "player_filter", filter.Player)
+	return s.repo.StreamMatches(ctx, filter, fn)
+}
+
+func (s *matchService) GetPlayerStats(ctx context.Context, player string) (domain.PlayerSummary, error) {
+	logger.DebugContext(ctx, "Getting player stats", "player_id", player)
+	return s.repo.GetPlayerStats(ctx, player)
+}
+
+func (s *matchService) GetMaxMatchNumber(ctx context.Context) (int, error) {
+	logger.DebugContext(ctx, "Getting highest match number")
+	return s.repo.GetMaxMatchNumber(ctx)
+}
+
+// GetUnfinishedMatches returns the matches that were never finished, rebuilt
+// from their events where they have them so their turns carry the power each
+// ball was returned with.
+func (s *matchService) GetUnfinishedMatches(ctx context.Context) ([]domain.Match, error) {
+	logger.DebugContext(ctx, "Getting unfinished matches")
+
+	matches, err := s.repo.GetUnfinishedMatches(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for i, match := range matches {
+		if matches[i], err = s.projectMatch(ctx, match); err != nil {
+			return nil, err
+		}
+	}
+	return matches, nil
+}
+
+func (s *matchService) TestConnection(ctx context.Context) (domain.DBStatus, error) {
+	logger.DebugContext(ctx, "Testing database connection")
+
+	start := time.Now()
+	if err := s.repo.TestConnection(ctx); err != nil {
+		return domain.DBStatus{}, err
+	}
+	status := domain.DBStatus{Latency: time.Since(start)}
+
+	version, err := s.repo.SchemaVersion(ctx)
+	if err != nil {
+		return domain.DBStatus{}, err
+	}
+	status.SchemaVersion = version
+
+	return status, nil
+}
+
+func (s *matchService) CheckTables(ctx context.Context) error {
+	return s.repo.CheckTables(ctx)
+}
@@ -0,0 +1,322 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"pingpong/adapters/memory"
+	"pingpong/domain"
+	"pingpong/ports"
+)
+
+// eventRepo stores events the way the MySQL repository does: match IDs come
+// from CreateMatch and sequence numbers count up per match.
+type eventRepo struct {
+	ports.MatchRepository
+	matches  int
+	events   []domain.Event
+	turns    map[int][]domain.Turn
+	finished map[int]string
+	down     bool
+	// failTurn makes appending the event of that turn fail once.
+	failTurn int
+}
+
+func newEventRepo() *eventRepo {
+	return &eventRepo{turns: map[int][]domain.Turn{}, finished: map[int]string{}}
+}
+
+// Transaction undoes the events and matches fn added when it fails.
+func (r *eventRepo) Transaction(ctx context.Context, fn func(repo ports.MatchRepository) error) error {
+	matches, events := r.matches, len(r.events)
+	if err := fn(r); err != nil {
+		r.matches, r.events = matches, r.events[:events]
+		return err
+	}
+	return nil
+}
+
+func (r *eventRepo) CreateMatch(ctx context.Context, match domain.Match) (int, error) {
+	if r.down {
+		return 0, domain.ErrStorageUnavailable
+	}
+	r.matches++
+	return r.matches, nil
+}
+
+func (r *eventRepo) AppendEvent(ctx context.Context, event domain.Event) (domain.Event, error) {
+	if r.down {
+		return domain.Event{}, domain.ErrStorageUnavailable
+	}
+	if event.IsTurn() && event.Payload.TurnNumber == r.failTurn {
+		r.failTurn = 0
+		return domain.Event{}, domain.ErrStorageUnavailable
+	}
+	r.events = append(r.events, event)
+	event.ID = len(r.events)
+	for _, e := range r.events {
+		if e.MatchID == event.MatchID {
+			event.Sequence++
+		}
+	}
+	return event, nil
+}
+
+func (r *eventRepo) ListEvents(ctx context.Context, matchID int) ([]domain.Event, error) {
+	var events []domain.Event
+	for i, e := range r.events {
+		if e.MatchID == matchID {
+			e.ID = i + 1
+			events = append(events, e)
+		}
+	}
+	return events, nil
+}
+
+func (r *eventRepo) GetMatchByID(ctx context.Context, id int) (domain.Match, error) {
+	return domain.Match{ID: id, Turns: r.turns[id]}, nil
+}
+
+func (r *eventRepo) GetUnfinishedMatches(ctx context.Context) ([]domain.Match, error) {
+	var matches []domain.Match
+	for id := 1; id <= r.matches; id++ {
+		if _, ok := r.finished[id]; !ok {
+			matches = append(matches, domain.Match{ID: id, Turns: r.turns[id]})
+		}
+	}
+	return matches, nil
+}
+
+func (r *eventRepo) AppendTurn(ctx context.Context, matchID int, turn domain.Turn) error {
+	r.turns[matchID] = append(r.turns[matchID], turn)
+	return nil
+}
+
+func (r *eventRepo) FinishMatch(ctx context.Context, matchID int, endTime time.Time, winner string) error {
+	r.finished[matchID] = winner
+	return nil
+}
+
+func TestRecordEventPublishes(t *testing.T) {
+	ctx := context.Background()
+	repo := newEventRepo()
+	publisher := memory.NewPublisher()
+	received := publisher.Subscribe(10)
+	svc := NewMatchService(repo, publisher)
+
+	started, err := svc.RecordEvent(ctx, domain.Event{
+		Type:    domain.EventMatchStarted,
+		Time:    time.Now(),
+		Payload: domain.EventPayload{MatchNumber: 1, RoutineID: "match-1"},
+	})
+	if err != nil {
+		t.Fatalf("MatchStarted: %v", err)
+	}
+	if started.MatchID != 1 {
+		t.Fatalf("MatchStarted got match ID %d, want 1", started.MatchID)
+	}
+
+	for _, event := range []domain.Event{
+		{Type: domain.EventBallServed, Payload: domain.EventPayload{TurnNumber: 1, Player: "A", BallPower: 80}},
+		{Type: domain.EventBallHit, Payload: domain.EventPayload{TurnNumber: 2, Player: "B", BallPower: 60}},
+		{Type: domain.EventMatchFinished, Payload: domain.EventPayload{Winner: "A", Reason: domain.ReasonWeakReturn}},
+	} {
+		event.MatchID = started.MatchID
+		event.Time = time.Now()
+		if _, err := svc.RecordEvent(ctx, event); err != nil {
+			t.Fatalf("%s: %v", event.Type, err)
+		}
+	}
+	publisher.Close()
+
+	want := []domain.EventType{domain.EventMatchStarted, domain.EventBallServed, domain.EventBallHit, domain.EventMatchFinished}
+	var got []domain.Event
+	for event := range received {
+		got = append(got, event)
+	}
+	if len(got) != len(want) {
+		t.Fatalf("subscriber got %d events, want %d", len(got), len(want))
+	}
+	for i, event := range got {
+		if event.Type != want[i] {
+			t.Errorf("event %d is %s, want %s", i, event.Type, want[i])
+		}
+		if event.MatchID != started.MatchID || event.Sequence != i+1 {
+			t.Errorf("event %d has match %d sequence %d, want match %d sequence %d",
+				i, event.MatchID, event.Sequence, started.MatchID, i+1)
+		}
+	}
+	if n := len(publisher.Events()); n != len(want) {
+		t.Errorf("publisher kept %d events, want %d", n, len(want))
+	}
+
+	if n := len(repo.turns[started.MatchID]); n != 2 {
+		t.Errorf("read model has %d turns, want 2", n)
+	}
+	if winner := repo.finished[started.MatchID]; winner != "A" {
+		t.Errorf("read model winner = %q, want A", winner)
+	}
+}
+
+func TestRecordEventPublishesWhileStorageIsDown(t *testing.T) {
+	ctx := context.Background()
+	repo := newEventRepo()
+	repo.down = true
+	publisher := memory.NewPublisher()
+	svc := NewMatchService(repo, publisher)
+
+	payload := domain.EventPayload{MatchNumber: 1, RoutineID: "match-1"}
+	started, err := svc.RecordEvent(ctx, domain.Event{Type: domain.EventMatchStarted, Time: time.Now(), Payload: payload})
+	if !errors.Is(err, domain.ErrStorageUnavailable) {
+		t.Fatalf("MatchStarted error = %v, want %v", err, domain.ErrStorageUnavailable)
+	}
+	payload.TurnNumber, payload.Player, payload.BallPower = 1, "A", 80
+	_, err = svc.RecordEvent(ctx, domain.Event{MatchID: started.MatchID, Type: domain.EventBallServed, Time: time.Now(), Payload: payload})
+	if err == nil {
+		t.Fatal("BallServed of an unstored match was recorded")
+	}
+
+	events := publisher.Events()
+	if len(events) != 2 {
+		t.Fatalf("published %d events, want 2", len(events))
+	}
+	for _, event := range events {
+		if event.MatchID != 0 || event.Payload.RoutineID != "match-1" {
+			t.Errorf("%s published with match ID %d and routine ID %q, want 0 and match-1",
+				event.Type, event.MatchID, event.Payload.RoutineID)
+		}
+	}
+}
+
+func TestPublishDoesNotBlockOtherSubscribers(t *testing.T) {
+	publisher := memory.NewPublisher()
+	slow := publisher.Subscribe(0)
+	defer publisher.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	blocked := make(chan error, 1)
+	go func() { blocked <- publisher.Publish(ctx, domain.Event{Type: domain.EventBallHit}) }()
+
+	// While the slow subscriber holds up that Publish, others still work.
+	done := make(chan struct{})
+	go func() {
+		publisher.Subscribe(1)
+		publisher.Events()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("Subscribe and Events blocked behind a slow subscriber")
+	}
+
+	if err := <-blocked; err != context.DeadlineExceeded {
+		t.Errorf("Publish to a full subscriber = %v, want %v", err, context.DeadlineExceeded)
+	}
+	select {
+	case <-slow:
+		t.Error("slow subscriber received an event it had no room for")
+	default:
+	}
+}
+
+func TestGetUnfinishedMatchesKeepsReturnPower(t *testing.T) {
+	ctx := context.Background()
+	svc := NewMatchService(newEventRepo(), nil)
+
+	started, err := svc.RecordEvent(ctx, domain.Event{
+		Type:    domain.EventMatchStarted,
+		Time:    time.Now(),
+		Payload: domain.EventPayload{MatchNumber: 1, RoutineID: "match-1"},
+	})
+	if err != nil {
+		t.Fatalf("MatchStarted: %v", err)
+	}
+	for _, event := range []domain.Event{
+		{Type: domain.EventBallServed, Payload: domain.EventPayload{TurnNumber: 1, Player: "A", BallPower: 80, ReturnPower: 60}},
+		{Type: domain.EventBallHit, Payload: domain.EventPayload{TurnNumber: 2, Player: "B", BallPower: 60, ReturnPower: 75}},
+	} {
+		event.MatchID = started.MatchID
+		event.Time = time.Now()
+		if _, err := svc.RecordEvent(ctx, event); err != nil {
+			t.Fatalf("%s: %v", event.Type, err)
+		}
+	}
+
+	matches, err := svc.GetUnfinishedMatches(ctx)
+	if err != nil {
+		t.Fatalf("GetUnfinishedMatches: %v", err)
+	}
+	if len(matches) != 1 || len(matches[0].Turns) != 2 {
+		t.Fatalf("got %+v, want one match with 2 turns", matches)
+	}
+	if last := matches[0].Turns[1]; last.BallPower != 60 || last.ReturnPower != 75 {
+		t.Errorf("last turn received %d and returned %d, want 60 and 75", last.BallPower, last.ReturnPower)
+	}
+}
+
+func TestFinishMatchRestoresMissingTurns(t *testing.T) {
+	ctx := context.Background()
+	repo := newEventRepo()
+	repo.failTurn = 2
+	svc := NewMatchService(repo, nil)
+
+	started, err := svc.RecordEvent(ctx, domain.Event{Type: domain.EventMatchStarted, Time: time.Now()})
+	if err != nil {
+		t.Fatalf("MatchStarted: %v", err)
+	}
+	turns := []domain.Turn{
+		{TurnNumber: 1, Player: "A", BallPower: 80, ReturnPower: 60},
+		{TurnNumber: 2, Player: "B", BallPower: 60, ReturnPower: 75},
+		{TurnNumber: 3, Player: "A", BallPower: 75, ReturnPower: 20},
+	}
+	for _, turn := range turns {
+		_, err := svc.RecordEvent(ctx, domain.TurnEvent(started.MatchID, turn))
+		if turn.TurnNumber == 2 && !errors.Is(err, domain.ErrStorageUnavailable) {
+			t.Fatalf("turn 2 error = %v, want %v", err, domain.ErrStorageUnavailable)
+		}
+		if turn.TurnNumber != 2 && err != nil {
+			t.Fatalf("turn %d: %v", turn.TurnNumber, err)
+		}
+	}
+
+	_, err = svc.FinishMatch(ctx, domain.Event{MatchID: started.MatchID, Type: domain.EventBallHit, Time: time.Now()}, turns)
+	if !errors.Is(err, domain.ErrInvalidArgument) {
+		t.Errorf("FinishMatch with a BallHit event = %v, want %v", err, domain.ErrInvalidArgument)
+	}
+
+	finished := domain.Event{
+		MatchID: started.MatchID,
+		Type:    domain.EventMatchFinished,
+		Time:    time.Now(),
+		Payload: domain.EventPayload{Winner: "B"},
+	}
+	if _, err := svc.FinishMatch(ctx, finished, turns); err != nil {
+		t.Fatalf("FinishMatch: %v", err)
+	}
+	if winner := repo.finished[started.MatchID]; winner != "B" {
+		t.Errorf("read model winner = %q, want B", winner)
+	}
+	if n := len(repo.turns[started.MatchID]); n != len(turns) {
+		t.Errorf("read model has %d turns, want %d", n, len(turns))
+	}
+
+	match, err := svc.GetMatchByID(ctx, started.MatchID)
+	if err != nil {
+		t.Fatalf("GetMatchByID: %v", err)
+	}
+	if len(match.Turns) != len(turns) {
+		t.Fatalf("match has %d turns, want %d", len(match.Turns), len(turns))
+	}
+	for i, turn := range match.Turns {
+		if turn.TurnNumber != i+1 || turn.ReturnPower != turns[i].ReturnPower {
+			t.Errorf("turn %d is %+v, want %+v", i, turn, turns[i])
+		}
+	}
+	if match.Winner != "B" {
+		t.Errorf("match winner = %q, want B", match.Winner)
+	}
+}
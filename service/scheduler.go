@@ -0,0 +1,165 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/robfig/cron/v3"
+
+	"pingpong/domain"
+	"pingpong/logging"
+	"pingpong/ports"
+)
+
+var schedulerLog = logging.For(logging.ComponentScheduler)
+
+// MaxScheduledMatches bounds how many matches one run of a schedule plays.
+const MaxScheduledMatches = 100000
+
+// Scheduler starts matches on the cron expressions of the stored schedules.
+// Only one run plays at a time: a schedule that fires while another run is
+// still going is skipped rather than queued.
+type Scheduler struct {
+	store   ports.ScheduleStore
+	player  ports.MatchPlayer
+	cron    *cron.Cron
+	running atomic.Bool
+
+	mu      sync.Mutex
+	entries map[int]cron.EntryID
+
+	// ctx is cancelled by Stop to end the run in progress.
+	ctx    context.Context
+	cancel context.CancelFunc
+}
+
+func NewScheduler(store ports.ScheduleStore, player ports.MatchPlayer) *Scheduler {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &Scheduler{
+		store:   store,
+		player:  player,
+		cron:    cron.New(),
+		entries: make(map[int]cron.EntryID),
+		ctx:     ctx,
+		cancel:  cancel,
+	}
+}
+
+// Start begins running the stored schedules and those created later. An
+// error loading the stored ones still leaves the scheduler running.
+func (s *Scheduler) Start(ctx context.Context) error {
+	s.cron.Start()
+	schedules, err := s.store.ListSchedules(ctx)
+	if err != nil {
+		return err
+	}
+	for _, schedule := range schedules {
+		if err := s.add(schedule); err != nil {
+			schedulerLog.WarnContext(ctx, "⚠️ Skipping schedule", "schedule_id", schedule.ID, "schedule", schedule.Name, "err", err)
+		}
+	}
+	schedulerLog.InfoContext(ctx, "⏰ Scheduler started", "schedules", len(schedules))
+	return nil
+}
+
+// Stop ends the run in progress after its current match and waits for it
+// until ctx is done.
+func (s *Scheduler) Stop(ctx context.Context) {
+	s.cancel()
+	select {
+	case <-s.cron.Stop().Done():
+	case <-ctx.Done():
+	}
+}
+
+func (s *Scheduler) CreateSchedule(ctx context.Context, schedule domain.Schedule) (domain.Schedule, error) {
+	schedule.Name = strings.TrimSpace(schedule.Name)
+	if schedule.Name == "" {
+		return domain.Schedule{}, fmt.Errorf("schedule name is required: %w", domain.ErrInvalidArgument)
+	}
+	if schedule.Matches < 1 || schedule.Matches > MaxScheduledMatches {
+		return domain.Schedule{}, fmt.Errorf("matches must be between 1 and %d: %w", MaxScheduledMatches, domain.ErrInvalidArgument)
+	}
+	if _, err := cron.ParseStandard(schedule.Cron); err != nil {
+		return domain.Schedule{}, fmt.Errorf("cron expression %q: %v: %w", schedule.Cron, err, domain.ErrInvalidArgument)
+	}
+
+	schedule.CreatedAt = time.Now()
+	schedule, err := s.store.CreateSchedule(ctx, schedule)
+	if err != nil {
+		return domain.Schedule{}, err
+	}
+	if err := s.add(schedule); err != nil {
+		// A stored schedule that never runs would only resurface at the next
+		// start, so it goes with the error.
+		if err := s.store.DeleteSchedule(ctx, schedule.ID); err != nil {
+			schedulerLog.WarnContext(ctx, "⚠️ Failed to delete schedule that could not be added", "schedule_id", schedule.ID, "err", err)
+		}
+		return domain.Schedule{}, err
+	}
+	schedulerLog.InfoContext(ctx, "⏰ Schedule created", "schedule_id", schedule.ID, "schedule", schedule.Name,
+		"matches", schedule.Matches, "cron", schedule.Cron)
+	return schedule, nil
+}
+
+func (s *Scheduler) ListSchedules(ctx context.Context) ([]domain.Schedule, error) {
+	return s.store.ListSchedules(ctx)
+}
+
+func (s *Scheduler) DeleteSchedule(ctx context.Context, id int) error {
+	if err := s.store.DeleteSchedule(ctx, id); err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	if entry, ok := s.entries[id]; ok {
+		s.cron.Remove(entry)
+		delete(s.entries, id)
+	}
+	s.mu.Unlock()
+	schedulerLog.InfoContext(ctx, "⏰ Schedule deleted", "schedule_id", id)
+	return nil
+}
+
+func (s *Scheduler) add(schedule domain.Schedule) error {
+	entry, err := s.cron.AddFunc(schedule.Cron, func() { s.run(schedule) })
+	if err != nil {
+		return fmt.Errorf("cron expression %q: %v: %w", schedule.Cron, err, domain.ErrInvalidArgument)
+	}
+
+	s.mu.Lock()
+	s.entries[schedule.ID] = entry
+	s.mu.Unlock()
+	return nil
+}
+
+// run plays the schedule's matches one after another, stopping early on the
+// first match that cannot be played or when the scheduler stops.
+func (s *Scheduler) run(schedule domain.Schedule) {
+	logger := schedulerLog.With("schedule_id", schedule.ID, "schedule", schedule.Name)
+	if !s.running.CompareAndSwap(false, true) {
+		logger.Warn("⏭️ Schedule skipped, another run is still playing")
+		return
+	}
+	defer s.running.Store(false)
+
+	logger.Info("⏰ Schedule starting", "matches", schedule.Matches)
+	started := time.Now()
+	played := 0
+	for played < schedule.Matches && s.ctx.Err() == nil {
+		if err := s.player.PlayMatch(s.ctx); err != nil {
+			if !errors.Is(err, context.Canceled) {
+				logger.Error("❌ Schedule stopped early", "played", played, "err", err)
+			}
+			break
+		}
+		played++
+	}
+	logger.Info("✅ Schedule finished", "played", played, "matches", schedule.Matches,
+		"duration", time.Since(started).Round(time.Second))
+}
@@ -0,0 +1,145 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"pingpong/domain"
+)
+
+// scheduleStore keeps schedules in memory, numbering them from 1.
+type scheduleStore struct {
+	mu        sync.Mutex
+	schedules map[int]domain.Schedule
+	next      int
+}
+
+func newScheduleStore() *scheduleStore {
+	return &scheduleStore{schedules: map[int]domain.Schedule{}}
+}
+
+func (s *scheduleStore) CreateSchedule(ctx context.Context, schedule domain.Schedule) (domain.Schedule, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.next++
+	schedule.ID = s.next
+	s.schedules[schedule.ID] = schedule
+	return schedule, nil
+}
+
+func (s *scheduleStore) ListSchedules(ctx context.Context) ([]domain.Schedule, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var schedules []domain.Schedule
+	for _, schedule := range s.schedules {
+		schedules = append(schedules, schedule)
+	}
+	return schedules, nil
+}
+
+func (s *scheduleStore) DeleteSchedule(ctx context.Context, id int) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.schedules[id]; !ok {
+		return domain.ErrScheduleNotFound
+	}
+	delete(s.schedules, id)
+	return nil
+}
+
+// blockingPlayer plays matches that last until release is closed.
+type blockingPlayer struct {
+	started chan struct{}
+	release chan struct{}
+	played  atomic.Int32
+}
+
+func (p *blockingPlayer) PlayMatch(ctx context.Context) error {
+	p.started <- struct{}{}
+	<-p.release
+	p.played.Add(1)
+	return nil
+}
+
+func TestCreateAndDeleteSchedule(t *testing.T) {
+	ctx := context.Background()
+	store := newScheduleStore()
+	s := NewScheduler(store, nil)
+
+	for _, invalid := range []domain.Schedule{
+		{Name: " ", Cron: "@hourly", Matches: 1},
+		{Name: "nightly", Cron: "@hourly", Matches: 0},
+		{Name: "nightly", Cron: "@hourly", Matches: MaxScheduledMatches + 1},
+		{Name: "nightly", Cron: "every night", Matches: 1},
+	} {
+		if _, err := s.CreateSchedule(ctx, invalid); !errors.Is(err, domain.ErrInvalidArgument) {
+			t.Errorf("CreateSchedule(%+v) = %v, want %v", invalid, err, domain.ErrInvalidArgument)
+		}
+	}
+	if n := len(store.schedules); n != 0 {
+		t.Fatalf("invalid schedules left %d rows", n)
+	}
+
+	schedule, err := s.CreateSchedule(ctx, domain.Schedule{Name: " nightly ", Cron: "0 3 * * *", Matches: 5})
+	if err != nil {
+		t.Fatalf("CreateSchedule: %v", err)
+	}
+	if schedule.ID == 0 || schedule.Name != "nightly" || schedule.CreatedAt.IsZero() {
+		t.Errorf("created %+v, want an ID, the trimmed name and a creation time", schedule)
+	}
+	if n := len(s.cron.Entries()); n != 1 {
+		t.Errorf("%d cron entries, want 1", n)
+	}
+
+	if err := s.DeleteSchedule(ctx, schedule.ID); err != nil {
+		t.Fatalf("DeleteSchedule: %v", err)
+	}
+	if n := len(s.cron.Entries()); n != 0 {
+		t.Errorf("%d cron entries after delete, want 0", n)
+	}
+	if len(store.schedules) != 0 || len(s.entries) != 0 {
+		t.Errorf("schedule still stored (%d) or tracked (%d) after delete", len(store.schedules), len(s.entries))
+	}
+	if err := s.DeleteSchedule(ctx, schedule.ID); !errors.Is(err, domain.ErrScheduleNotFound) {
+		t.Errorf("deleting it again = %v, want %v", err, domain.ErrScheduleNotFound)
+	}
+}
+
+func TestScheduleRunsDoNotOverlap(t *testing.T) {
+	player := &blockingPlayer{started: make(chan struct{}, 2), release: make(chan struct{})}
+	s := NewScheduler(newScheduleStore(), player)
+	schedule := domain.Schedule{ID: 1, Name: "burst", Matches: 2}
+
+	done := make(chan struct{})
+	go func() {
+		s.run(schedule)
+		close(done)
+	}()
+	<-player.started
+
+	// Fires while the first run is still playing its first match.
+	s.run(domain.Schedule{ID: 2, Name: "other", Matches: 1})
+
+	close(player.release)
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("first run did not finish")
+	}
+	if n := player.played.Load(); n != 2 {
+		t.Errorf("played %d matches, want the first run's 2 only", n)
+	}
+	if s.running.Load() {
+		t.Error("scheduler still marked as running")
+	}
+
+	// Once it is done, the next run plays.
+	s.run(domain.Schedule{ID: 2, Name: "other", Matches: 1})
+	if n := player.played.Load(); n != 3 {
+		t.Errorf("played %d matches, want 3", n)
+	}
+}